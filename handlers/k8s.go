@@ -9,6 +9,7 @@ import (
 
 	"github.com/hendzormati/simple-k8s-mcp-server/pkg/k8s"
 	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -41,6 +42,21 @@ func parseJSONStringToMap(jsonStr string) (map[string]string, error) {
 	return result, nil
 }
 
+// Helper function to parse JSON string to a string slice
+func parseJSONStringToSlice(jsonStr string) ([]string, error) {
+	if jsonStr == "" {
+		return nil, nil
+	}
+
+	var result []string
+	err := json.Unmarshal([]byte(jsonStr), &result)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON format: %v", err)
+	}
+
+	return result, nil
+}
+
 // ========== NAMESPACE HANDLERS ==========
 
 // ListNamespaces returns a handler function for the listNamespaces tool
@@ -522,6 +538,38 @@ func GetNamespaceAllResources(client *k8s.Client) func(ctx context.Context, requ
 	}
 }
 
+// PreviewNamespaceDeletion returns a handler function for the previewNamespaceDeletion tool
+func PreviewNamespaceDeletion(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if client == nil {
+			return nil, fmt.Errorf("kubernetes client not available")
+		}
+
+		args := getArguments(request)
+
+		namespace, exists := args["namespace"]
+		if !exists {
+			return nil, fmt.Errorf("missing required argument: namespace")
+		}
+		namespaceStr, ok := namespace.(string)
+		if !ok || namespaceStr == "" {
+			return nil, fmt.Errorf("namespace must be a non-empty string")
+		}
+
+		preview, err := client.PreviewNamespaceDeletion(ctx, namespaceStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to preview namespace deletion: %v", err)
+		}
+
+		jsonResponse, err := json.Marshal(preview)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize response: %v", err)
+		}
+
+		return mcp.NewToolResultText(string(jsonResponse)), nil
+	}
+}
+
 // ForceDeleteNamespace returns a handler function for the forceDeleteNamespace tool
 func ForceDeleteNamespace(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -736,6 +784,81 @@ func SetNamespaceLimitRange(client *k8s.Client) func(ctx context.Context, reques
 	}
 }
 
+// CleanupNamespace returns a handler function for the cleanupNamespace tool
+func CleanupNamespace(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if client == nil {
+			return nil, fmt.Errorf("kubernetes client not available")
+		}
+
+		args := getArguments(request)
+
+		namespace, exists := args["namespace"]
+		if !exists {
+			return nil, fmt.Errorf("missing required argument: namespace")
+		}
+		namespaceStr, ok := namespace.(string)
+		if !ok || namespaceStr == "" {
+			return nil, fmt.Errorf("namespace must be a non-empty string")
+		}
+
+		failedPods := true
+		if v, exists := args["failedPods"]; exists {
+			if vBool, ok := v.(bool); ok {
+				failedPods = vBool
+			}
+		}
+
+		completedJobs := true
+		if v, exists := args["completedJobs"]; exists {
+			if vBool, ok := v.(bool); ok {
+				completedJobs = vBool
+			}
+		}
+
+		jobMinAgeHours := 24.0
+		if v, exists := args["jobMinAgeHours"]; exists {
+			if vFloat, ok := v.(float64); ok && vFloat >= 0 {
+				jobMinAgeHours = vFloat
+			}
+		}
+
+		orphanedConfigMaps := false
+		if v, exists := args["orphanedConfigMaps"]; exists {
+			if vBool, ok := v.(bool); ok {
+				orphanedConfigMaps = vBool
+			}
+		}
+
+		orphanedSecrets := false
+		if v, exists := args["orphanedSecrets"]; exists {
+			if vBool, ok := v.(bool); ok {
+				orphanedSecrets = vBool
+			}
+		}
+
+		dryRun := false
+		if dry, exists := args["dryRun"]; exists {
+			if dryBool, ok := dry.(bool); ok {
+				dryRun = dryBool
+			}
+		}
+
+		jobMinAge := time.Duration(jobMinAgeHours * float64(time.Hour))
+		result, err := client.CleanupNamespace(ctx, namespaceStr, failedPods, completedJobs, orphanedConfigMaps, orphanedSecrets, jobMinAge, dryRun)
+		if err != nil {
+			return nil, fmt.Errorf("failed to clean up namespace: %v", err)
+		}
+
+		jsonResponse, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize response: %v", err)
+		}
+
+		return mcp.NewToolResultText(string(jsonResponse)), nil
+	}
+}
+
 // ========== POD HANDLERS ==========
 
 // ListPods returns a handler function for the listPods tool
@@ -882,6 +1005,59 @@ func GetPodLogs(client *k8s.Client) func(ctx context.Context, request mcp.CallTo
 			}
 		}
 
+		var fromTime time.Time
+		if from, exists := args["fromTime"]; exists {
+			if fromStr, ok := from.(string); ok && fromStr != "" {
+				parsed, err := time.Parse(time.RFC3339Nano, fromStr)
+				if err != nil {
+					return nil, fmt.Errorf("fromTime must be an RFC3339 timestamp (e.g., '2024-05-01T14:00:00Z'): %v", err)
+				}
+				fromTime = parsed
+			}
+		}
+
+		var toTime time.Time
+		if to, exists := args["toTime"]; exists {
+			if toStr, ok := to.(string); ok && toStr != "" {
+				parsed, err := time.Parse(time.RFC3339Nano, toStr)
+				if err != nil {
+					return nil, fmt.Errorf("toTime must be an RFC3339 timestamp (e.g., '2024-05-01T14:00:00Z'): %v", err)
+				}
+				toTime = parsed
+			}
+		}
+
+		if !fromTime.IsZero() || !toTime.IsZero() {
+			if follow {
+				return nil, fmt.Errorf("follow cannot be combined with fromTime/toTime")
+			}
+			if !fromTime.IsZero() && !toTime.IsZero() && toTime.Before(fromTime) {
+				return nil, fmt.Errorf("toTime must not be before fromTime")
+			}
+
+			// Tailing would usually cut into the window, so only tail when asked to explicitly
+			if _, exists := args["tailLines"]; !exists {
+				tailLines = 0
+			}
+
+			windowed, err := client.GetPodLogsInRange(ctx, namespaceStr, nameStr, containerName, tailLines, previous, fromTime, toTime)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get pod logs: %v", err)
+			}
+
+			windowed["podName"] = nameStr
+			windowed["namespace"] = namespaceStr
+			windowed["containerName"] = containerName
+			windowed["tailLines"] = tailLines
+
+			jsonResponse, err := json.Marshal(windowed)
+			if err != nil {
+				return nil, fmt.Errorf("failed to serialize response: %v", err)
+			}
+
+			return mcp.NewToolResultText(string(jsonResponse)), nil
+		}
+
 		logs, err := client.GetPodLogs(ctx, namespaceStr, nameStr, containerName, tailLines, follow, previous)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get pod logs: %v", err)
@@ -960,6 +1136,74 @@ func DeletePod(client *k8s.Client) func(ctx context.Context, request mcp.CallToo
 	}
 }
 
+// EvictPod returns a handler function for the evictPod tool
+func EvictPod(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if client == nil {
+			return nil, fmt.Errorf("kubernetes client not available")
+		}
+
+		args := getArguments(request)
+
+		name, exists := args["name"]
+		if !exists {
+			return nil, fmt.Errorf("missing required argument: name")
+		}
+		nameStr, ok := name.(string)
+		if !ok || nameStr == "" {
+			return nil, fmt.Errorf("name must be a non-empty string")
+		}
+
+		namespace, exists := args["namespace"]
+		if !exists {
+			return nil, fmt.Errorf("missing required argument: namespace")
+		}
+		namespaceStr, ok := namespace.(string)
+		if !ok || namespaceStr == "" {
+			return nil, fmt.Errorf("namespace must be a non-empty string")
+		}
+
+		// Leave the grace period unset when omitted so an explicit 0 still means immediate termination
+		var gracePeriodSeconds *int64
+		if grace, exists := args["gracePeriodSeconds"]; exists {
+			if graceFloat, ok := grace.(float64); ok {
+				graceInt := int64(graceFloat)
+				gracePeriodSeconds = &graceInt
+			}
+		}
+
+		dryRun := false
+		if dr, exists := args["dryRun"]; exists {
+			if drBool, ok := dr.(bool); ok {
+				dryRun = drBool
+			}
+		}
+
+		result, err := client.EvictPod(ctx, namespaceStr, nameStr, gracePeriodSeconds, dryRun)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evict pod: %v", err)
+		}
+
+		if allowed, _ := result["allowed"].(bool); allowed {
+			result["message"] = fmt.Sprintf("Pod '%s' in namespace '%s' evicted successfully", nameStr, namespaceStr)
+			if dryRun {
+				result["message"] = fmt.Sprintf("Pod '%s' in namespace '%s' can be evicted", nameStr, namespaceStr)
+			}
+		} else if pdbName, ok := result["pdbName"].(string); ok {
+			result["message"] = fmt.Sprintf("Eviction of pod '%s' blocked by PodDisruptionBudget '%s'", nameStr, pdbName)
+		} else {
+			result["message"] = fmt.Sprintf("Eviction of pod '%s' blocked by a PodDisruptionBudget", nameStr)
+		}
+
+		jsonResponse, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize response: %v", err)
+		}
+
+		return mcp.NewToolResultText(string(jsonResponse)), nil
+	}
+}
+
 // GetPodEvents returns a handler function for the getPodEvents tool
 func GetPodEvents(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -1212,6 +1456,23 @@ func CreatePod(client *k8s.Client) func(ctx context.Context, request mcp.CallToo
 			return nil, fmt.Errorf("manifest must be a non-empty string")
 		}
 
+		waitForScheduled := false
+		if wait, exists := args["waitForScheduled"]; exists {
+			if waitBool, ok := wait.(bool); ok {
+				waitForScheduled = waitBool
+			}
+		}
+
+		scheduleTimeout := 30
+		if timeoutArg, exists := args["scheduleTimeout"]; exists {
+			switch v := timeoutArg.(type) {
+			case float64:
+				scheduleTimeout = int(v)
+			case int:
+				scheduleTimeout = v
+			}
+		}
+
 		// Create the pod
 		pod, err := client.CreatePod(ctx, namespaceStr, manifestStr)
 		if err != nil {
@@ -1224,6 +1485,22 @@ func CreatePod(client *k8s.Client) func(ctx context.Context, request mcp.CallToo
 			"status":  "created",
 		}
 
+		// Optionally wait for the scheduler to place the pod
+		if waitForScheduled {
+			podName, _ := pod["name"].(string)
+			scheduling, err := client.WaitForPodScheduled(ctx, namespaceStr, podName, scheduleTimeout)
+			if err != nil {
+				scheduling = map[string]interface{}{
+					"scheduled": false,
+					"error":     err.Error(),
+				}
+			}
+			response["scheduling"] = scheduling
+			if nodeName, ok := scheduling["nodeName"].(string); ok && nodeName != "" {
+				pod["nodeName"] = nodeName
+			}
+		}
+
 		jsonResponse, err := json.Marshal(response)
 		if err != nil {
 			return nil, fmt.Errorf("failed to serialize response: %v", err)
@@ -1311,6 +1588,68 @@ func UpdatePod(client *k8s.Client) func(ctx context.Context, request mcp.CallToo
 	}
 }
 
+// WaitForPodCondition returns a handler function for the waitForPodCondition tool
+func WaitForPodCondition(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if client == nil {
+			return nil, fmt.Errorf("kubernetes client not available")
+		}
+
+		args := getArguments(request)
+
+		name, exists := args["name"]
+		if !exists {
+			return nil, fmt.Errorf("missing required argument: name")
+		}
+		nameStr, ok := name.(string)
+		if !ok || nameStr == "" {
+			return nil, fmt.Errorf("name must be a non-empty string")
+		}
+
+		condition, exists := args["condition"]
+		if !exists {
+			return nil, fmt.Errorf("missing required argument: condition")
+		}
+		conditionStr, ok := condition.(string)
+		if !ok || conditionStr == "" {
+			return nil, fmt.Errorf("condition must be a non-empty string")
+		}
+
+		namespace := "default"
+		if ns, exists := args["namespace"]; exists {
+			if nsStr, ok := ns.(string); ok && nsStr != "" {
+				namespace = nsStr
+			}
+		}
+
+		status := "True"
+		if st, exists := args["status"]; exists {
+			if stStr, ok := st.(string); ok && stStr != "" {
+				status = stStr
+			}
+		}
+
+		timeoutSeconds := 60
+		if timeout, exists := args["timeoutSeconds"]; exists {
+			if timeoutFloat, ok := timeout.(float64); ok && timeoutFloat > 0 {
+				timeoutSeconds = int(timeoutFloat)
+			}
+		}
+
+		result, err := client.WaitForPodCondition(ctx, namespace, nameStr, conditionStr, status, timeoutSeconds)
+		if err != nil {
+			return nil, fmt.Errorf("failed to wait for pod condition: %v", err)
+		}
+
+		jsonResponse, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize response: %v", err)
+		}
+
+		return mcp.NewToolResultText(string(jsonResponse)), nil
+	}
+}
+
 // ========== DEPLOYMENT HANDLERS ==========
 
 // ListDeployments returns a handler function for the listDeployments tool
@@ -2091,8 +2430,8 @@ func RestartDeployment(client *k8s.Client) func(ctx context.Context, request mcp
 	}
 }
 
-// WaitForDeployment returns a handler function for the waitForDeployment tool
-func WaitForDeployment(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+// TriggerReloadByLabel returns a handler function for the triggerReloadByLabel tool
+func TriggerReloadByLabel(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		if client == nil {
 			return nil, fmt.Errorf("kubernetes client not available")
@@ -2100,13 +2439,13 @@ func WaitForDeployment(client *k8s.Client) func(ctx context.Context, request mcp
 
 		args := getArguments(request)
 
-		name, exists := args["name"]
+		labelSelector, exists := args["labelSelector"]
 		if !exists {
-			return nil, fmt.Errorf("missing required argument: name")
+			return nil, fmt.Errorf("missing required argument: labelSelector")
 		}
-		nameStr, ok := name.(string)
-		if !ok || nameStr == "" {
-			return nil, fmt.Errorf("name must be a non-empty string")
+		labelSelectorStr, ok := labelSelector.(string)
+		if !ok || labelSelectorStr == "" {
+			return nil, fmt.Errorf("labelSelector must be a non-empty string")
 		}
 
 		namespace := "default"
@@ -2116,19 +2455,16 @@ func WaitForDeployment(client *k8s.Client) func(ctx context.Context, request mcp
 			}
 		}
 
-		timeout := 300
-		if timeoutArg, exists := args["timeout"]; exists {
-			switch v := timeoutArg.(type) {
-			case float64:
-				timeout = int(v)
-			case int:
-				timeout = v
+		dryRun := false
+		if dry, exists := args["dryRun"]; exists {
+			if dryBool, ok := dry.(bool); ok {
+				dryRun = dryBool
 			}
 		}
 
-		result, err := client.WaitForDeployment(ctx, nameStr, namespace, timeout)
+		result, err := client.TriggerReloadByLabel(ctx, namespace, labelSelectorStr, dryRun)
 		if err != nil {
-			return nil, fmt.Errorf("failed to wait for deployment: %v", err)
+			return nil, fmt.Errorf("failed to trigger reload: %v", err)
 		}
 
 		jsonResponse, err := json.Marshal(result)
@@ -2140,8 +2476,8 @@ func WaitForDeployment(client *k8s.Client) func(ctx context.Context, request mcp
 	}
 }
 
-// SetDeploymentImage returns a handler function for the setDeploymentImage tool
-func SetDeploymentImage(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+// WaitForDeployment returns a handler function for the waitForDeployment tool
+func WaitForDeployment(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		if client == nil {
 			return nil, fmt.Errorf("kubernetes client not available")
@@ -2158,12 +2494,129 @@ func SetDeploymentImage(client *k8s.Client) func(ctx context.Context, request mc
 			return nil, fmt.Errorf("name must be a non-empty string")
 		}
 
-		container, exists := args["container"]
-		if !exists {
-			return nil, fmt.Errorf("missing required argument: container")
-		}
-		containerStr, ok := container.(string)
-		if !ok || containerStr == "" {
+		namespace := "default"
+		if ns, exists := args["namespace"]; exists {
+			if nsStr, ok := ns.(string); ok && nsStr != "" {
+				namespace = nsStr
+			}
+		}
+
+		timeout := 300
+		if timeoutArg, exists := args["timeout"]; exists {
+			switch v := timeoutArg.(type) {
+			case float64:
+				timeout = int(v)
+			case int:
+				timeout = v
+			}
+		}
+
+		result, err := client.WaitForDeployment(ctx, nameStr, namespace, timeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to wait for deployment: %v", err)
+		}
+
+		jsonResponse, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize response: %v", err)
+		}
+
+		return mcp.NewToolResultText(string(jsonResponse)), nil
+	}
+}
+
+// WatchRollout returns a handler function for the watchRollout tool
+func WatchRollout(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if client == nil {
+			return nil, fmt.Errorf("kubernetes client not available")
+		}
+
+		args := getArguments(request)
+
+		name, exists := args["name"]
+		if !exists {
+			return nil, fmt.Errorf("missing required argument: name")
+		}
+		nameStr, ok := name.(string)
+		if !ok || nameStr == "" {
+			return nil, fmt.Errorf("name must be a non-empty string")
+		}
+
+		namespace := "default"
+		if ns, exists := args["namespace"]; exists {
+			if nsStr, ok := ns.(string); ok && nsStr != "" {
+				namespace = nsStr
+			}
+		}
+
+		timeout := 300
+		if timeoutArg, exists := args["timeout"]; exists {
+			if timeoutFloat, ok := timeoutArg.(float64); ok && timeoutFloat > 0 {
+				timeout = int(timeoutFloat)
+			}
+		}
+		if timeout > 1800 {
+			return nil, fmt.Errorf("timeout cannot exceed 1800 seconds")
+		}
+
+		// Relay each rollout event to the client as a progress notification when it asked for them
+		var progressToken mcp.ProgressToken
+		if request.Params.Meta != nil {
+			progressToken = request.Params.Meta.ProgressToken
+		}
+		mcpServer := server.ServerFromContext(ctx)
+		sent := 0
+		onEvent := func(event map[string]interface{}) {
+			if mcpServer == nil || progressToken == nil {
+				return
+			}
+			sent++
+			_ = mcpServer.SendNotificationToClient(ctx, "notifications/progress", map[string]any{
+				"progressToken": progressToken,
+				"progress":      sent,
+				"message":       event["message"],
+			})
+		}
+
+		result, err := client.WatchRollout(ctx, nameStr, namespace, timeout, onEvent)
+		if err != nil {
+			return nil, fmt.Errorf("failed to watch rollout: %v", err)
+		}
+
+		jsonResponse, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize response: %v", err)
+		}
+
+		return mcp.NewToolResultText(string(jsonResponse)), nil
+	}
+}
+
+// SetDeploymentImage returns a handler function for the setDeploymentImage tool
+func SetDeploymentImage(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if client == nil {
+			return nil, fmt.Errorf("kubernetes client not available")
+		}
+
+		args := getArguments(request)
+
+		name, exists := args["name"]
+		if !exists {
+			return nil, fmt.Errorf("missing required argument: name")
+		}
+		nameStr, ok := name.(string)
+		if !ok || nameStr == "" {
+			return nil, fmt.Errorf("name must be a non-empty string")
+		}
+
+		container, exists := args["container"]
+		if !exists {
+			return nil, fmt.Errorf("missing required argument: container")
+		}
+		containerStr, ok := container.(string)
+		if !ok || containerStr == "" {
 			return nil, fmt.Errorf("container must be a non-empty string")
 		}
 
@@ -2343,7 +2796,24 @@ func GetPodsHealthStatus(client *k8s.Client) func(ctx context.Context, request m
 			}
 		}
 
-		healthStatus, err := client.GetPodsHealthStatus(ctx, namespace, labelSelector)
+		includeUsage := false
+		if usage, exists := args["includeUsage"]; exists {
+			if usageBool, ok := usage.(bool); ok {
+				includeUsage = usageBool
+			}
+		}
+
+		sortBy := "cpu"
+		if sort, exists := args["sortBy"]; exists {
+			if sortStr, ok := sort.(string); ok && sortStr != "" {
+				if sortStr != "cpu" && sortStr != "memory" {
+					return nil, fmt.Errorf("sortBy must be 'cpu' or 'memory'")
+				}
+				sortBy = sortStr
+			}
+		}
+
+		healthStatus, err := client.GetPodsHealthStatus(ctx, namespace, labelSelector, includeUsage, sortBy)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get pods health status: %v", err)
 		}
@@ -2357,6 +2827,118 @@ func GetPodsHealthStatus(client *k8s.Client) func(ctx context.Context, request m
 	}
 }
 
+// GetResourceRatios returns a handler function for the getResourceRatios tool
+func GetResourceRatios(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if client == nil {
+			return nil, fmt.Errorf("kubernetes client not available")
+		}
+
+		args := getArguments(request)
+
+		namespace := "default"
+		if ns, exists := args["namespace"]; exists {
+			if nsStr, ok := ns.(string); ok && nsStr != "" {
+				namespace = nsStr
+			}
+		}
+
+		labelSelector := ""
+		if selector, exists := args["labelSelector"]; exists {
+			if selectorStr, ok := selector.(string); ok {
+				labelSelector = selectorStr
+			}
+		}
+
+		maxRatio := 4.0
+		if ratio, exists := args["maxRatio"]; exists {
+			if ratioFloat, ok := ratio.(float64); ok && ratioFloat > 0 {
+				maxRatio = ratioFloat
+			}
+		}
+
+		limit := 20
+		if l, exists := args["limit"]; exists {
+			if lFloat, ok := l.(float64); ok && lFloat >= 0 {
+				limit = int(lFloat)
+			}
+		}
+
+		report, err := client.GetResourceRatios(ctx, namespace, labelSelector, maxRatio, limit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get resource ratios: %v", err)
+		}
+
+		jsonResponse, err := json.Marshal(report)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize response: %v", err)
+		}
+
+		return mcp.NewToolResultText(string(jsonResponse)), nil
+	}
+}
+
+// GetContainerRestarts returns a handler function for the getContainerRestarts tool
+func GetContainerRestarts(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if client == nil {
+			return nil, fmt.Errorf("kubernetes client not available")
+		}
+
+		args := getArguments(request)
+
+		podName := ""
+		if name, exists := args["name"]; exists {
+			if nameStr, ok := name.(string); ok {
+				podName = nameStr
+			}
+		}
+
+		deploymentName := ""
+		if deployment, exists := args["deployment"]; exists {
+			if deploymentStr, ok := deployment.(string); ok {
+				deploymentName = deploymentStr
+			}
+		}
+
+		if podName == "" && deploymentName == "" {
+			return nil, fmt.Errorf("either 'name' or 'deployment' must be provided")
+		}
+		if podName != "" && deploymentName != "" {
+			return nil, fmt.Errorf("only one of 'name' or 'deployment' can be provided")
+		}
+
+		namespace := "default"
+		if ns, exists := args["namespace"]; exists {
+			if nsStr, ok := ns.(string); ok && nsStr != "" {
+				namespace = nsStr
+			}
+		}
+
+		sampleSeconds := 10
+		if sample, exists := args["sampleSeconds"]; exists {
+			if sampleFloat, ok := sample.(float64); ok && sampleFloat >= 0 {
+				sampleSeconds = int(sampleFloat)
+			}
+		}
+		if sampleSeconds > 120 {
+			return nil, fmt.Errorf("sampleSeconds cannot exceed 120")
+		}
+
+		restarts, err := client.GetContainerRestarts(ctx, namespace, podName, deploymentName, sampleSeconds)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get container restarts: %v", err)
+		}
+
+		jsonResponse, err := json.Marshal(restarts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize response: %v", err)
+		}
+
+		return mcp.NewToolResultText(string(jsonResponse)), nil
+	}
+}
+
 // PatchDeployment returns a handler function for the patchDeployment tool
 func PatchDeployment(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -2558,6 +3140,67 @@ func SetDeploymentResources(client *k8s.Client) func(ctx context.Context, reques
 	}
 }
 
+// SetRolloutTiming returns a handler function for the setRolloutTiming tool
+func SetRolloutTiming(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if client == nil {
+			return nil, fmt.Errorf("kubernetes client not available")
+		}
+
+		args := getArguments(request)
+
+		name, exists := args["name"]
+		if !exists {
+			return nil, fmt.Errorf("missing required argument: name")
+		}
+		nameStr, ok := name.(string)
+		if !ok || nameStr == "" {
+			return nil, fmt.Errorf("name must be a non-empty string")
+		}
+
+		namespace := "default"
+		if ns, exists := args["namespace"]; exists {
+			if nsStr, ok := ns.(string); ok && nsStr != "" {
+				namespace = nsStr
+			}
+		}
+
+		var progressDeadlineSeconds *int32
+		if deadline, exists := args["progressDeadlineSeconds"]; exists {
+			deadlineFloat, ok := deadline.(float64)
+			if !ok {
+				return nil, fmt.Errorf("progressDeadlineSeconds must be a number")
+			}
+			value := int32(deadlineFloat)
+			progressDeadlineSeconds = &value
+		}
+
+		var minReadySeconds *int32
+		if minReady, exists := args["minReadySeconds"]; exists {
+			minReadyFloat, ok := minReady.(float64)
+			if !ok {
+				return nil, fmt.Errorf("minReadySeconds must be a number")
+			}
+			value := int32(minReadyFloat)
+			minReadySeconds = &value
+		}
+
+		result, err := client.SetRolloutTiming(ctx, nameStr, namespace, progressDeadlineSeconds, minReadySeconds)
+		if err != nil {
+			return nil, fmt.Errorf("failed to set rollout timing: %v", err)
+		}
+
+		result["message"] = fmt.Sprintf("Rollout timing updated for deployment '%s'", nameStr)
+
+		jsonResponse, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize response: %v", err)
+		}
+
+		return mcp.NewToolResultText(string(jsonResponse)), nil
+	}
+}
+
 // GetDeploymentMetrics returns a handler function for the getDeploymentMetrics tool
 func GetDeploymentMetrics(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -2682,7 +3325,17 @@ func ScaleAllDeployments(client *k8s.Client) func(ctx context.Context, request m
 			}
 		}
 
-		result, err := client.ScaleAllDeployments(ctx, namespaceStr, replicasInt32, labelSelector, dryRun)
+		concurrency := 5
+		if conc, exists := args["concurrency"]; exists {
+			if concFloat, ok := conc.(float64); ok {
+				concurrency = int(concFloat)
+			}
+		}
+		if concurrency < 1 || concurrency > 20 {
+			return nil, fmt.Errorf("concurrency must be between 1 and 20")
+		}
+
+		result, err := client.ScaleAllDeployments(ctx, namespaceStr, replicasInt32, labelSelector, dryRun, concurrency)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scale all deployments: %v", err)
 		}
@@ -2696,8 +3349,8 @@ func ScaleAllDeployments(client *k8s.Client) func(ctx context.Context, request m
 	}
 }
 
-// GetNamespaceResourceUsage returns a handler function for the getNamespaceResourceUsage tool
-func GetNamespaceResourceUsage(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+// ReconcileReplicas returns a handler function for the reconcileReplicas tool
+func ReconcileReplicas(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		if client == nil {
 			return nil, fmt.Errorf("kubernetes client not available")
@@ -2705,28 +3358,26 @@ func GetNamespaceResourceUsage(client *k8s.Client) func(ctx context.Context, req
 
 		args := getArguments(request)
 
-		namespace, exists := args["namespace"]
-		if !exists {
-			return nil, fmt.Errorf("missing required argument: namespace")
-		}
-		namespaceStr, ok := namespace.(string)
-		if !ok || namespaceStr == "" {
-			return nil, fmt.Errorf("namespace must be a non-empty string")
+		namespace := ""
+		if ns, exists := args["namespace"]; exists {
+			if nsStr, ok := ns.(string); ok {
+				namespace = nsStr
+			}
 		}
 
-		includeMetrics := false
-		if metrics, exists := args["includeMetrics"]; exists {
-			if metricsBool, ok := metrics.(bool); ok {
-				includeMetrics = metricsBool
+		includeProgressing := false
+		if include, exists := args["includeProgressing"]; exists {
+			if includeBool, ok := include.(bool); ok {
+				includeProgressing = includeBool
 			}
 		}
 
-		usage, err := client.GetNamespaceResourceUsage(ctx, namespaceStr, includeMetrics)
+		result, err := client.ReconcileReplicas(ctx, namespace, includeProgressing)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get namespace resource usage: %v", err)
+			return nil, fmt.Errorf("failed to reconcile replicas: %v", err)
 		}
 
-		jsonResponse, err := json.Marshal(usage)
+		jsonResponse, err := json.Marshal(result)
 		if err != nil {
 			return nil, fmt.Errorf("failed to serialize response: %v", err)
 		}
@@ -2735,8 +3386,8 @@ func GetNamespaceResourceUsage(client *k8s.Client) func(ctx context.Context, req
 	}
 }
 
-// GetClusterOverview returns a handler function for the getClusterOverview tool
-func GetClusterOverview(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+// GetNamespaceResourceUsage returns a handler function for the getNamespaceResourceUsage tool
+func GetNamespaceResourceUsage(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		if client == nil {
 			return nil, fmt.Errorf("kubernetes client not available")
@@ -2744,14 +3395,60 @@ func GetClusterOverview(client *k8s.Client) func(ctx context.Context, request mc
 
 		args := getArguments(request)
 
-		includeMetrics := false
-		if metrics, exists := args["includeMetrics"]; exists {
+		namespace, exists := args["namespace"]
+		if !exists {
+			return nil, fmt.Errorf("missing required argument: namespace")
+		}
+		namespaceStr, ok := namespace.(string)
+		if !ok || namespaceStr == "" {
+			return nil, fmt.Errorf("namespace must be a non-empty string")
+		}
+
+		includeMetrics := false
+		if metrics, exists := args["includeMetrics"]; exists {
+			if metricsBool, ok := metrics.(bool); ok {
+				includeMetrics = metricsBool
+			}
+		}
+
+		usage, err := client.GetNamespaceResourceUsage(ctx, namespaceStr, includeMetrics)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get namespace resource usage: %v", err)
+		}
+
+		jsonResponse, err := json.Marshal(usage)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize response: %v", err)
+		}
+
+		return mcp.NewToolResultText(string(jsonResponse)), nil
+	}
+}
+
+// GetClusterOverview returns a handler function for the getClusterOverview tool
+func GetClusterOverview(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if client == nil {
+			return nil, fmt.Errorf("kubernetes client not available")
+		}
+
+		args := getArguments(request)
+
+		includeMetrics := false
+		if metrics, exists := args["includeMetrics"]; exists {
 			if metricsBool, ok := metrics.(bool); ok {
 				includeMetrics = metricsBool
 			}
 		}
 
-		overview, err := client.GetClusterOverview(ctx, includeMetrics)
+		refresh := false
+		if refreshArg, exists := args["refresh"]; exists {
+			if refreshBool, ok := refreshArg.(bool); ok {
+				refresh = refreshBool
+			}
+		}
+
+		overview, err := client.GetClusterOverview(ctx, includeMetrics, refresh)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get cluster overview: %v", err)
 		}
@@ -2765,6 +3462,27 @@ func GetClusterOverview(client *k8s.Client) func(ctx context.Context, request mc
 	}
 }
 
+// GetControlPlaneHealth returns a handler function for the getControlPlaneHealth tool
+func GetControlPlaneHealth(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if client == nil {
+			return nil, fmt.Errorf("kubernetes client not available")
+		}
+
+		health, err := client.GetControlPlaneHealth(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get control plane health: %v", err)
+		}
+
+		jsonResponse, err := json.Marshal(health)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize response: %v", err)
+		}
+
+		return mcp.NewToolResultText(string(jsonResponse)), nil
+	}
+}
+
 // ========== SERVICE HANDLERS ==========
 
 // ListServices returns a handler function for the listServices tool
@@ -3521,6 +4239,75 @@ func GetServiceTopology(client *k8s.Client) func(ctx context.Context, request mc
 	}
 }
 
+// TraceServicePath returns a handler function for the traceServicePath tool
+func TraceServicePath(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if client == nil {
+			return nil, fmt.Errorf("kubernetes client not available")
+		}
+
+		args := getArguments(request)
+
+		name, exists := args["name"]
+		if !exists {
+			return nil, fmt.Errorf("missing required argument: name")
+		}
+		nameStr, ok := name.(string)
+		if !ok || nameStr == "" {
+			return nil, fmt.Errorf("name must be a non-empty string")
+		}
+
+		namespace := "default"
+		if ns, exists := args["namespace"]; exists {
+			if nsStr, ok := ns.(string); ok && nsStr != "" {
+				namespace = nsStr
+			}
+		}
+
+		trace, err := client.TraceServicePath(ctx, nameStr, namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to trace service path: %v", err)
+		}
+
+		jsonResponse, err := json.Marshal(trace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize response: %v", err)
+		}
+
+		return mcp.NewToolResultText(string(jsonResponse)), nil
+	}
+}
+
+// CheckPortConflicts returns a handler function for the checkPortConflicts tool
+func CheckPortConflicts(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if client == nil {
+			return nil, fmt.Errorf("kubernetes client not available")
+		}
+
+		args := getArguments(request)
+
+		namespace := ""
+		if ns, exists := args["namespace"]; exists {
+			if nsStr, ok := ns.(string); ok {
+				namespace = nsStr
+			}
+		}
+
+		conflicts, err := client.CheckPortConflicts(ctx, namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check port conflicts: %v", err)
+		}
+
+		jsonResponse, err := json.Marshal(conflicts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize response: %v", err)
+		}
+
+		return mcp.NewToolResultText(string(jsonResponse)), nil
+	}
+}
+
 // CreateServiceFromPods returns a handler function for the createServiceFromPods tool
 func CreateServiceFromPods(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -3616,3 +4403,613 @@ func CreateServiceFromPods(client *k8s.Client) func(ctx context.Context, request
 		return mcp.NewToolResultText(string(jsonResponse)), nil
 	}
 }
+
+// ========== ADMISSION WEBHOOK HANDLERS ==========
+
+// ListWebhooks returns a handler function for the listWebhooks tool
+func ListWebhooks(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if client == nil {
+			return nil, fmt.Errorf("kubernetes client not available")
+		}
+
+		args := getArguments(request)
+
+		webhookType := "all"
+		if t, exists := args["type"]; exists {
+			if tStr, ok := t.(string); ok && tStr != "" {
+				webhookType = strings.ToLower(tStr)
+			}
+		}
+
+		resource := ""
+		if r, exists := args["resource"]; exists {
+			if rStr, ok := r.(string); ok {
+				resource = rStr
+			}
+		}
+
+		webhooks, err := client.ListWebhooks(ctx, webhookType, resource)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list webhooks: %v", err)
+		}
+
+		jsonResponse, err := json.Marshal(webhooks)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize response: %v", err)
+		}
+
+		return mcp.NewToolResultText(string(jsonResponse)), nil
+	}
+}
+
+// ========== CRONJOB HANDLERS ==========
+
+// ListCronJobs returns a handler function for the listCronJobs tool
+func ListCronJobs(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if client == nil {
+			return nil, fmt.Errorf("kubernetes client not available")
+		}
+
+		args := getArguments(request)
+
+		namespace := "default"
+		if ns, exists := args["namespace"]; exists {
+			if nsStr, ok := ns.(string); ok && nsStr != "" {
+				namespace = nsStr
+			}
+		}
+
+		cronJobs, err := client.ListCronJobs(ctx, namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list cronjobs: %v", err)
+		}
+
+		response := map[string]interface{}{
+			"namespace": namespace,
+			"cronJobs":  cronJobs,
+			"count":     len(cronJobs),
+		}
+
+		jsonResponse, err := json.Marshal(response)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize response: %v", err)
+		}
+
+		return mcp.NewToolResultText(string(jsonResponse)), nil
+	}
+}
+
+// TriggerCronJob returns a handler function for the triggerCronJob tool
+func TriggerCronJob(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if client == nil {
+			return nil, fmt.Errorf("kubernetes client not available")
+		}
+
+		args := getArguments(request)
+
+		name, exists := args["name"]
+		if !exists {
+			return nil, fmt.Errorf("missing required argument: name")
+		}
+		nameStr, ok := name.(string)
+		if !ok || nameStr == "" {
+			return nil, fmt.Errorf("name must be a non-empty string")
+		}
+
+		namespace := "default"
+		if ns, exists := args["namespace"]; exists {
+			if nsStr, ok := ns.(string); ok && nsStr != "" {
+				namespace = nsStr
+			}
+		}
+
+		containerName := ""
+		if c, exists := args["container"]; exists {
+			if cStr, ok := c.(string); ok {
+				containerName = cStr
+			}
+		}
+
+		var command []string
+		if commandArg, exists := args["command"]; exists {
+			if commandStr, ok := commandArg.(string); ok && commandStr != "" {
+				parsedCommand, err := parseJSONStringToSlice(commandStr)
+				if err != nil {
+					return nil, fmt.Errorf("invalid command JSON: %v", err)
+				}
+				command = parsedCommand
+			}
+		}
+
+		var commandArgs []string
+		if argsArg, exists := args["args"]; exists {
+			if argsStr, ok := argsArg.(string); ok && argsStr != "" {
+				parsedArgs, err := parseJSONStringToSlice(argsStr)
+				if err != nil {
+					return nil, fmt.Errorf("invalid args JSON: %v", err)
+				}
+				commandArgs = parsedArgs
+			}
+		}
+
+		var env map[string]string
+		if envArg, exists := args["env"]; exists {
+			if envStr, ok := envArg.(string); ok && envStr != "" {
+				parsedEnv, err := parseJSONStringToMap(envStr)
+				if err != nil {
+					return nil, fmt.Errorf("invalid env JSON: %v", err)
+				}
+				env = parsedEnv
+			}
+		}
+
+		result, err := client.TriggerCronJob(ctx, namespace, nameStr, containerName, command, commandArgs, env)
+		if err != nil {
+			return nil, fmt.Errorf("failed to trigger cronjob: %v", err)
+		}
+
+		result["message"] = fmt.Sprintf("Job '%s' created from cronjob '%s'", result["jobName"], nameStr)
+
+		jsonResponse, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize response: %v", err)
+		}
+
+		return mcp.NewToolResultText(string(jsonResponse)), nil
+	}
+}
+
+// ========== GENERIC RESOURCE HANDLERS ==========
+
+// ExportKind returns a handler function for the exportKind tool
+func ExportKind(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if client == nil {
+			return nil, fmt.Errorf("kubernetes client not available")
+		}
+
+		args := getArguments(request)
+
+		kind, exists := args["kind"]
+		if !exists {
+			return nil, fmt.Errorf("missing required argument: kind")
+		}
+		kindStr, ok := kind.(string)
+		if !ok || kindStr == "" {
+			return nil, fmt.Errorf("kind must be a non-empty string")
+		}
+
+		namespace := "default"
+		if ns, exists := args["namespace"]; exists {
+			if nsStr, ok := ns.(string); ok && nsStr != "" {
+				namespace = nsStr
+			}
+		}
+
+		includeSystem := false
+		if include, exists := args["includeSystem"]; exists {
+			if includeBool, ok := include.(bool); ok {
+				includeSystem = includeBool
+			}
+		}
+
+		excludeSelector := ""
+		if selector, exists := args["excludeSelector"]; exists {
+			if selectorStr, ok := selector.(string); ok {
+				excludeSelector = selectorStr
+			}
+		}
+
+		bundle, err := client.ExportKind(ctx, kindStr, namespace, includeSystem, excludeSelector)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export kind: %v", err)
+		}
+
+		jsonResponse, err := json.Marshal(bundle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize response: %v", err)
+		}
+
+		return mcp.NewToolResultText(string(jsonResponse)), nil
+	}
+}
+
+// DriftFromLastApplied returns a handler function for the driftFromLastApplied tool
+func DriftFromLastApplied(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if client == nil {
+			return nil, fmt.Errorf("kubernetes client not available")
+		}
+
+		args := getArguments(request)
+
+		kind, exists := args["kind"]
+		if !exists {
+			return nil, fmt.Errorf("missing required argument: kind")
+		}
+		kindStr, ok := kind.(string)
+		if !ok || kindStr == "" {
+			return nil, fmt.Errorf("kind must be a non-empty string")
+		}
+
+		name, exists := args["name"]
+		if !exists {
+			return nil, fmt.Errorf("missing required argument: name")
+		}
+		nameStr, ok := name.(string)
+		if !ok || nameStr == "" {
+			return nil, fmt.Errorf("name must be a non-empty string")
+		}
+
+		namespace := "default"
+		if ns, exists := args["namespace"]; exists {
+			if nsStr, ok := ns.(string); ok && nsStr != "" {
+				namespace = nsStr
+			}
+		}
+
+		drift, err := client.DriftFromLastApplied(ctx, kindStr, nameStr, namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check drift: %v", err)
+		}
+
+		jsonResponse, err := json.Marshal(drift)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize response: %v", err)
+		}
+
+		return mcp.NewToolResultText(string(jsonResponse)), nil
+	}
+}
+
+// MergeFields returns a handler function for the mergeFields tool
+func MergeFields(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if client == nil {
+			return nil, fmt.Errorf("kubernetes client not available")
+		}
+
+		args := getArguments(request)
+
+		kind, exists := args["kind"]
+		if !exists {
+			return nil, fmt.Errorf("missing required argument: kind")
+		}
+		kindStr, ok := kind.(string)
+		if !ok || kindStr == "" {
+			return nil, fmt.Errorf("kind must be a non-empty string")
+		}
+
+		name, exists := args["name"]
+		if !exists {
+			return nil, fmt.Errorf("missing required argument: name")
+		}
+		nameStr, ok := name.(string)
+		if !ok || nameStr == "" {
+			return nil, fmt.Errorf("name must be a non-empty string")
+		}
+
+		fields, exists := args["fields"]
+		if !exists {
+			return nil, fmt.Errorf("missing required argument: fields")
+		}
+		fieldsStr, ok := fields.(string)
+		if !ok || fieldsStr == "" {
+			return nil, fmt.Errorf("fields must be a non-empty JSON string")
+		}
+
+		namespace := "default"
+		if ns, exists := args["namespace"]; exists {
+			if nsStr, ok := ns.(string); ok && nsStr != "" {
+				namespace = nsStr
+			}
+		}
+
+		dryRun := false
+		if dry, exists := args["dryRun"]; exists {
+			if dryBool, ok := dry.(bool); ok {
+				dryRun = dryBool
+			}
+		}
+
+		result, err := client.MergeFields(ctx, kindStr, nameStr, namespace, fieldsStr, dryRun)
+		if err != nil {
+			return nil, fmt.Errorf("failed to merge fields: %v", err)
+		}
+
+		jsonResponse, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize response: %v", err)
+		}
+
+		return mcp.NewToolResultText(string(jsonResponse)), nil
+	}
+}
+
+// RebaseManifest returns a handler function for the rebaseManifest tool
+func RebaseManifest(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if client == nil {
+			return nil, fmt.Errorf("kubernetes client not available")
+		}
+
+		args := getArguments(request)
+
+		targetNamespace, exists := args["targetNamespace"]
+		if !exists {
+			return nil, fmt.Errorf("missing required argument: targetNamespace")
+		}
+		targetNamespaceStr, ok := targetNamespace.(string)
+		if !ok || targetNamespaceStr == "" {
+			return nil, fmt.Errorf("targetNamespace must be a non-empty string")
+		}
+
+		manifest := ""
+		if m, exists := args["manifest"]; exists {
+			if mStr, ok := m.(string); ok {
+				manifest = mStr
+			}
+		}
+
+		kind := ""
+		if k, exists := args["kind"]; exists {
+			if kStr, ok := k.(string); ok {
+				kind = kStr
+			}
+		}
+
+		name := ""
+		if n, exists := args["name"]; exists {
+			if nStr, ok := n.(string); ok {
+				name = nStr
+			}
+		}
+
+		sourceNamespace := ""
+		if ns, exists := args["sourceNamespace"]; exists {
+			if nsStr, ok := ns.(string); ok {
+				sourceNamespace = nsStr
+			}
+		}
+
+		result, err := client.RebaseManifest(ctx, manifest, kind, name, sourceNamespace, targetNamespaceStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to rebase manifest: %v", err)
+		}
+
+		jsonResponse, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize response: %v", err)
+		}
+
+		return mcp.NewToolResultText(string(jsonResponse)), nil
+	}
+}
+
+// GetRecentlyModified returns a handler function for the getRecentlyModified tool
+func GetRecentlyModified(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if client == nil {
+			return nil, fmt.Errorf("kubernetes client not available")
+		}
+
+		args := getArguments(request)
+
+		kind, exists := args["kind"]
+		if !exists {
+			return nil, fmt.Errorf("missing required argument: kind")
+		}
+		kindStr, ok := kind.(string)
+		if !ok || kindStr == "" {
+			return nil, fmt.Errorf("kind must be a non-empty string")
+		}
+
+		namespace := "default"
+		if ns, exists := args["namespace"]; exists {
+			if nsStr, ok := ns.(string); ok && nsStr != "" {
+				namespace = nsStr
+			}
+		}
+
+		var since time.Duration
+		if minutes, exists := args["sinceMinutes"]; exists {
+			if minutesFloat, ok := minutes.(float64); ok && minutesFloat > 0 {
+				since = time.Duration(minutesFloat * float64(time.Minute))
+			}
+		}
+
+		limit := 20
+		if l, exists := args["limit"]; exists {
+			if lFloat, ok := l.(float64); ok && lFloat > 0 {
+				limit = int(lFloat)
+			}
+		}
+
+		result, err := client.GetRecentlyModified(ctx, kindStr, namespace, since, limit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get recently modified resources: %v", err)
+		}
+
+		jsonResponse, err := json.Marshal(result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize response: %v", err)
+		}
+
+		return mcp.NewToolResultText(string(jsonResponse)), nil
+	}
+}
+
+// ========== RBAC HANDLERS ==========
+
+// GetNamespaceAccess returns a handler function for the getNamespaceAccess tool
+func GetNamespaceAccess(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if client == nil {
+			return nil, fmt.Errorf("kubernetes client not available")
+		}
+
+		args := getArguments(request)
+
+		namespace, exists := args["namespace"]
+		if !exists {
+			return nil, fmt.Errorf("missing required argument: namespace")
+		}
+		namespaceStr, ok := namespace.(string)
+		if !ok || namespaceStr == "" {
+			return nil, fmt.Errorf("namespace must be a non-empty string")
+		}
+
+		var resources []string
+		if r, exists := args["resources"]; exists {
+			if rStr, ok := r.(string); ok && rStr != "" {
+				for _, resource := range strings.Split(rStr, ",") {
+					if resource = strings.TrimSpace(resource); resource != "" {
+						resources = append(resources, resource)
+					}
+				}
+			}
+		}
+
+		includeSystem := false
+		if include, exists := args["includeSystem"]; exists {
+			if includeBool, ok := include.(bool); ok {
+				includeSystem = includeBool
+			}
+		}
+
+		access, err := client.GetNamespaceAccess(ctx, namespaceStr, resources, includeSystem)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get namespace access: %v", err)
+		}
+
+		jsonResponse, err := json.Marshal(access)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize response: %v", err)
+		}
+
+		return mcp.NewToolResultText(string(jsonResponse)), nil
+	}
+}
+
+// ========== METRICS HISTORY HANDLERS ==========
+
+// GetMetricsHistory handles the getMetricsHistory tool
+func GetMetricsHistory(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if client == nil {
+			return nil, fmt.Errorf("kubernetes client not available")
+		}
+
+		args := getArguments(request)
+
+		kind, exists := args["kind"]
+		if !exists {
+			return nil, fmt.Errorf("missing required argument: kind")
+		}
+		kindStr, ok := kind.(string)
+		if !ok || kindStr == "" {
+			return nil, fmt.Errorf("kind must be a non-empty string")
+		}
+
+		name, exists := args["name"]
+		if !exists {
+			return nil, fmt.Errorf("missing required argument: name")
+		}
+		nameStr, ok := name.(string)
+		if !ok || nameStr == "" {
+			return nil, fmt.Errorf("name must be a non-empty string")
+		}
+
+		namespace := "default"
+		if ns, exists := args["namespace"]; exists {
+			if nsStr, ok := ns.(string); ok && nsStr != "" {
+				namespace = nsStr
+			}
+		}
+
+		minutes := 30.0
+		if m, exists := args["minutes"]; exists {
+			if mFloat, ok := m.(float64); ok {
+				if mFloat < 0 {
+					return nil, fmt.Errorf("minutes cannot be negative")
+				}
+				minutes = mFloat
+			}
+		}
+
+		history, err := client.GetMetricsHistory(kindStr, namespace, nameStr, time.Duration(minutes*float64(time.Minute)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get metrics history: %v", err)
+		}
+
+		jsonResponse, err := json.Marshal(history)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize response: %v", err)
+		}
+
+		return mcp.NewToolResultText(string(jsonResponse)), nil
+	}
+}
+
+// ========== INCIDENT REPORT HANDLERS ==========
+
+// GenerateIncidentReport returns a handler function for the generateIncidentReport tool
+func GenerateIncidentReport(client *k8s.Client) func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if client == nil {
+			return nil, fmt.Errorf("kubernetes client not available")
+		}
+
+		args := getArguments(request)
+
+		namespace := "default"
+		if ns, exists := args["namespace"]; exists {
+			if nsStr, ok := ns.(string); ok && nsStr != "" {
+				namespace = nsStr
+			}
+		}
+
+		deployment := ""
+		if d, exists := args["deployment"]; exists {
+			if dStr, ok := d.(string); ok {
+				deployment = dStr
+			}
+		}
+
+		since := time.Hour
+		if minutes, exists := args["sinceMinutes"]; exists {
+			if minutesFloat, ok := minutes.(float64); ok && minutesFloat > 0 {
+				since = time.Duration(minutesFloat * float64(time.Minute))
+			}
+		}
+
+		logTailLines := int64(50)
+		if lines, exists := args["logTailLines"]; exists {
+			if linesFloat, ok := lines.(float64); ok && linesFloat > 0 {
+				logTailLines = int64(linesFloat)
+			}
+		}
+
+		maxLogPods := 5
+		if maxPods, exists := args["maxLogPods"]; exists {
+			if maxPodsFloat, ok := maxPods.(float64); ok && maxPodsFloat > 0 {
+				maxLogPods = int(maxPodsFloat)
+			}
+		}
+
+		report, err := client.GenerateIncidentReport(ctx, namespace, deployment, since, logTailLines, maxLogPods)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate incident report: %v", err)
+		}
+
+		jsonResponse, err := json.Marshal(report)
+		if err != nil {
+			return nil, fmt.Errorf("failed to serialize response: %v", err)
+		}
+
+		return mcp.NewToolResultText(string(jsonResponse)), nil
+	}
+}
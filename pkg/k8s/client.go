@@ -6,19 +6,36 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	sigsyaml "sigs.k8s.io/yaml"
 
 	"gopkg.in/yaml.v3"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -26,7 +43,20 @@ import (
 )
 
 type Client struct {
-	clientset *kubernetes.Clientset
+	clientset     kubernetes.Interface
+	dynamicClient dynamic.Interface
+
+	// Short-lived cache for GetClusterOverview, keyed by includeMetrics
+	overviewMu       sync.Mutex
+	overviewTTL      time.Duration
+	overviewCache    map[bool]map[string]interface{}
+	overviewCachedAt map[bool]time.Time
+
+	// Optional background usage sampler (see StartMetricsHistory)
+	metricsHistory *metricsHistory
+
+	// Upper bound for any replica count requested through the server (0 means unlimited)
+	maxReplicas int32
 }
 
 // NewClient creates a new Kubernetes client with auto-detection for various cluster types
@@ -171,8 +201,13 @@ Error details: %v`, err)
 		return nil, fmt.Errorf("failed to create Kubernetes clientset with %s: %v", configSource, err)
 	}
 
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kubernetes dynamic client with %s: %v", configSource, err)
+	}
+
 	// Final connectivity test
-	client := &Client{clientset: clientset}
+	client := &Client{clientset: clientset, dynamicClient: dynamicClient}
 	if err := client.TestConnection(); err != nil {
 		// If connection fails, try with relaxed TLS settings for development
 		if isDevelopmentMode() {
@@ -180,7 +215,10 @@ Error details: %v`, err)
 			config.TLSClientConfig.Insecure = true
 			clientset, err = kubernetes.NewForConfig(config)
 			if err == nil {
-				client = &Client{clientset: clientset}
+				dynamicClient, err = dynamic.NewForConfig(config)
+			}
+			if err == nil {
+				client = &Client{clientset: clientset, dynamicClient: dynamicClient}
 				if err := client.TestConnection(); err == nil {
 					fmt.Println("⚠️  Connected with insecure TLS (development mode only)")
 					configSource += " (insecure)"
@@ -548,6 +586,219 @@ func (c *Client) GetNamespaceAllResources(ctx context.Context, namespace string)
 	return result, nil
 }
 
+// protectedNamespaces are namespaces whose deletion breaks the cluster itself
+var protectedNamespaces = map[string]bool{
+	"default":         true,
+	"kube-system":     true,
+	"kube-public":     true,
+	"kube-node-lease": true,
+}
+
+// PreviewNamespaceDeletion lists what deleting a namespace would destroy and assesses the risk, without deleting anything
+func (c *Client) PreviewNamespaceDeletion(ctx context.Context, name string) (map[string]interface{}, error) {
+	namespace, err := c.clientset.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace '%s': %v", name, err)
+	}
+
+	var risks []map[string]interface{}
+	addRisk := func(level, kind, resourceName, reason string) {
+		risks = append(risks, map[string]interface{}{
+			"level":  level,
+			"kind":   kind,
+			"name":   resourceName,
+			"reason": reason,
+		})
+	}
+
+	if protectedNamespaces[name] {
+		addRisk("critical", "Namespace", name, "system namespace; deleting it breaks the cluster")
+	}
+	if namespace.Status.Phase == corev1.NamespaceTerminating {
+		addRisk("medium", "Namespace", name, "namespace is already terminating; use getNamespaceAllResources to see what is blocking it")
+	}
+
+	resources := map[string]interface{}{}
+	total := 0
+
+	// Namespace deletion removes every namespaced kind, custom resources included, so discover them from the server
+	kinds, skipped, err := c.discoverNamespacedKinds()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rk := range kinds {
+		list, err := c.resourceInterface(rk, name).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			skipped = append(skipped, fmt.Sprintf("%s: %v", rk.Resource.Resource, err))
+			continue
+		}
+		if len(list.Items) == 0 {
+			continue
+		}
+
+		names := make([]string, 0, len(list.Items))
+		for _, obj := range list.Items {
+			names = append(names, obj.GetName())
+
+			// pvc-protection is released as soon as no pod uses the claim, so it rarely blocks deletion
+			for _, finalizer := range obj.GetFinalizers() {
+				if finalizer == "kubernetes.io/pvc-protection" {
+					continue
+				}
+				addRisk("medium", rk.Kind, obj.GetName(), fmt.Sprintf("finalizer '%s' may make deletion hang if its controller is gone", finalizer))
+			}
+		}
+		sort.Strings(names)
+
+		key := rk.Kind
+		if _, taken := resources[key]; taken && rk.Resource.Group != "" {
+			key = rk.Kind + "." + rk.Resource.Group
+		}
+		resources[key] = map[string]interface{}{
+			"apiVersion": rk.APIVersion,
+			"count":      len(names),
+			"names":      names,
+		}
+		total += len(names)
+	}
+
+	// Persistent data: claims whose volume is deleted with them, and stateful workloads
+	pvcs, err := c.clientset.CoreV1().PersistentVolumeClaims(name).List(ctx, metav1.ListOptions{})
+	if err == nil {
+		for _, pvc := range pvcs.Items {
+			size := ""
+			if storage, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; ok {
+				size = storage.String()
+			}
+			if pvc.Spec.VolumeName == "" {
+				addRisk("medium", "PersistentVolumeClaim", pvc.Name, "claim is not bound; no data yet, but the claim is lost")
+				continue
+			}
+
+			pv, err := c.clientset.CoreV1().PersistentVolumes().Get(ctx, pvc.Spec.VolumeName, metav1.GetOptions{})
+			if err != nil {
+				addRisk("high", "PersistentVolumeClaim", pvc.Name, fmt.Sprintf("bound to volume '%s' (%s) whose reclaim policy could not be read; assume its data is deleted", pvc.Spec.VolumeName, size))
+				continue
+			}
+
+			switch pv.Spec.PersistentVolumeReclaimPolicy {
+			case corev1.PersistentVolumeReclaimRetain:
+				addRisk("medium", "PersistentVolumeClaim", pvc.Name, fmt.Sprintf("volume '%s' (%s) has reclaim policy Retain; data is kept but the volume is left Released and must be reclaimed manually", pv.Name, size))
+			default:
+				addRisk("high", "PersistentVolumeClaim", pvc.Name, fmt.Sprintf("volume '%s' (%s) has reclaim policy %s; its data will be permanently deleted", pv.Name, size, pv.Spec.PersistentVolumeReclaimPolicy))
+			}
+		}
+	}
+
+	statefulSets, err := c.clientset.AppsV1().StatefulSets(name).List(ctx, metav1.ListOptions{})
+	if err == nil {
+		for _, sts := range statefulSets.Items {
+			if len(sts.Spec.VolumeClaimTemplates) > 0 {
+				addRisk("high", "StatefulSet", sts.Name, fmt.Sprintf("stateful workload with %d volume claim template(s); its per-replica data goes with the claims", len(sts.Spec.VolumeClaimTemplates)))
+			} else {
+				addRisk("medium", "StatefulSet", sts.Name, "stateful workload; check whether it keeps state outside persistent volumes")
+			}
+		}
+	}
+
+	for _, finalizer := range namespace.Spec.Finalizers {
+		if finalizer != corev1.FinalizerKubernetes {
+			addRisk("medium", "Namespace", name, fmt.Sprintf("namespace finalizer '%s' may make deletion hang", finalizer))
+		}
+	}
+
+	riskLevel := "low"
+	counts := map[string]int{"critical": 0, "high": 0, "medium": 0}
+	for _, risk := range risks {
+		counts[risk["level"].(string)]++
+	}
+	switch {
+	case counts["critical"] > 0:
+		riskLevel = "critical"
+	case counts["high"] > 0:
+		riskLevel = "high"
+	case counts["medium"] > 0:
+		riskLevel = "medium"
+	}
+
+	if risks == nil {
+		risks = []map[string]interface{}{}
+	}
+
+	result := map[string]interface{}{
+		"namespace":      name,
+		"phase":          string(namespace.Status.Phase),
+		"riskLevel":      riskLevel,
+		"riskCounts":     counts,
+		"risks":          risks,
+		"resources":      resources,
+		"totalResources": total,
+		"note":           "Preview only, nothing was deleted. Resources are discovered from the API server, custom resources included",
+	}
+	if len(skipped) > 0 {
+		result["skipped"] = skipped
+	}
+
+	return result, nil
+}
+
+// previewSkippedGroups are served groups whose namespaced resources are not deleted with the namespace or duplicate another group
+var previewSkippedGroups = map[string]bool{
+	"events.k8s.io":  true, // same objects as core events
+	"metrics.k8s.io": true, // computed on the fly, nothing is stored
+}
+
+// discoverNamespacedKinds returns every listable namespaced resource served by the cluster, in its preferred version.
+// Groups whose discovery failed are reported as skipped instead of failing the whole lookup.
+func (c *Client) discoverNamespacedKinds() ([]resourceKind, []string, error) {
+	lists, err := c.clientset.Discovery().ServerPreferredNamespacedResources()
+	if err != nil && len(lists) == 0 {
+		return nil, nil, fmt.Errorf("failed to discover namespaced resources: %v", err)
+	}
+
+	var skipped []string
+	if err != nil {
+		skipped = append(skipped, fmt.Sprintf("discovery: %v", err))
+	}
+
+	var kinds []resourceKind
+	for _, list := range lists {
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			skipped = append(skipped, fmt.Sprintf("%s: %v", list.GroupVersion, err))
+			continue
+		}
+		if previewSkippedGroups[gv.Group] {
+			continue
+		}
+
+		for _, apiResource := range list.APIResources {
+			// Subresources such as pods/log are not objects of their own
+			if strings.Contains(apiResource.Name, "/") {
+				continue
+			}
+			listable := false
+			for _, verb := range apiResource.Verbs {
+				if verb == "list" {
+					listable = true
+				}
+			}
+			if !listable {
+				continue
+			}
+			kinds = append(kinds, resourceKind{
+				Kind:       apiResource.Kind,
+				APIVersion: gv.String(),
+				Resource:   gv.WithResource(apiResource.Name),
+				Namespaced: true,
+			})
+		}
+	}
+
+	return kinds, skipped, nil
+}
+
 // ForceDeleteNamespace attempts to force delete a namespace using multiple strategies
 func (c *Client) ForceDeleteNamespace(ctx context.Context, name string) error {
 	// Strategy 1: Try regular delete first
@@ -845,6 +1096,337 @@ func (c *Client) SetNamespaceLimitRange(ctx context.Context, namespace, manifest
 	}
 }
 
+// CleanupNamespace deletes failed pods, old completed jobs and (optionally) unreferenced configmaps/secrets in one pass.
+// jobMinAge is how long a job must have been complete before it is removed.
+func (c *Client) CleanupNamespace(ctx context.Context, namespace string, failedPods, completedJobs, orphanedConfigMaps, orphanedSecrets bool, jobMinAge time.Duration, dryRun bool) (map[string]interface{}, error) {
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+
+	deleteOptions := metav1.DeleteOptions{}
+	if dryRun {
+		deleteOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
+	totalDeleted := 0
+	totalFailed := 0
+	newCategory := func(enabled bool) map[string]interface{} {
+		return map[string]interface{}{
+			"enabled": enabled,
+			"deleted": []string{},
+			"failed":  []map[string]interface{}{},
+		}
+	}
+	recordDeletion := func(category map[string]interface{}, name string, err error) {
+		if err != nil {
+			category["failed"] = append(category["failed"].([]map[string]interface{}), map[string]interface{}{
+				"name":  name,
+				"error": err.Error(),
+			})
+			totalFailed++
+			return
+		}
+		category["deleted"] = append(category["deleted"].([]string), name)
+		totalDeleted++
+	}
+
+	categories := map[string]interface{}{}
+
+	// Failed and evicted pods
+	podCategory := newCategory(failedPods)
+	if failedPods {
+		pods, err := c.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+			FieldSelector: "status.phase=Failed",
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list failed pods in namespace '%s': %v", namespace, err)
+		}
+		evicted := 0
+		for _, pod := range pods.Items {
+			if pod.Status.Reason == "Evicted" {
+				evicted++
+			}
+			err := c.clientset.CoreV1().Pods(namespace).Delete(ctx, pod.Name, deleteOptions)
+			recordDeletion(podCategory, pod.Name, err)
+		}
+		podCategory["evicted"] = evicted
+	}
+	categories["failedPods"] = podCategory
+
+	// Completed jobs older than jobMinAge
+	jobCategory := newCategory(completedJobs)
+	if completedJobs {
+		jobs, err := c.clientset.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list jobs in namespace '%s': %v", namespace, err)
+		}
+		background := metav1.DeletePropagationBackground
+		jobDeleteOptions := deleteOptions
+		jobDeleteOptions.PropagationPolicy = &background
+		for _, job := range jobs.Items {
+			if job.Status.CompletionTime == nil || time.Since(job.Status.CompletionTime.Time) < jobMinAge {
+				continue
+			}
+			err := c.clientset.BatchV1().Jobs(namespace).Delete(ctx, job.Name, jobDeleteOptions)
+			recordDeletion(jobCategory, job.Name, err)
+		}
+		jobCategory["minAge"] = jobMinAge.String()
+	}
+	categories["completedJobs"] = jobCategory
+
+	// Orphaned configmaps and secrets need the full reference graph of the namespace
+	configMapCategory := newCategory(orphanedConfigMaps)
+	secretCategory := newCategory(orphanedSecrets)
+	if orphanedConfigMaps || orphanedSecrets {
+		// Jobs removed above no longer hold references; excluding them explicitly keeps dry runs and real runs in agreement
+		removedJobs := map[string]bool{}
+		for _, jobName := range jobCategory["deleted"].([]string) {
+			removedJobs[jobName] = true
+		}
+		referencedConfigMaps, referencedSecrets, err := c.namespaceConfigReferences(ctx, namespace, removedJobs)
+		if err != nil {
+			return nil, err
+		}
+
+		if orphanedConfigMaps {
+			configMaps, err := c.clientset.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list configmaps in namespace '%s': %v", namespace, err)
+			}
+			for _, configMap := range configMaps.Items {
+				if referencedConfigMaps[configMap.Name] || len(configMap.OwnerReferences) > 0 || configMap.Name == "kube-root-ca.crt" {
+					continue
+				}
+				err := c.clientset.CoreV1().ConfigMaps(namespace).Delete(ctx, configMap.Name, deleteOptions)
+				recordDeletion(configMapCategory, configMap.Name, err)
+			}
+		}
+
+		if orphanedSecrets {
+			secrets, err := c.clientset.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list secrets in namespace '%s': %v", namespace, err)
+			}
+			// Unreferenced secrets of preserved types are reported instead of deleted
+			unreferencedKept := []string{}
+			for _, secret := range secrets.Items {
+				// Token secrets and Helm release records are used without any pod reference
+				if referencedSecrets[secret.Name] || len(secret.OwnerReferences) > 0 ||
+					secret.Type == corev1.SecretTypeServiceAccountToken || strings.HasPrefix(string(secret.Type), "helm.sh/") {
+					continue
+				}
+				if preservedSecretTypes[secret.Type] {
+					unreferencedKept = append(unreferencedKept, secret.Name)
+					continue
+				}
+				err := c.clientset.CoreV1().Secrets(namespace).Delete(ctx, secret.Name, deleteOptions)
+				recordDeletion(secretCategory, secret.Name, err)
+			}
+			secretCategory["keptByType"] = unreferencedKept
+		}
+	}
+	categories["orphanedConfigMaps"] = configMapCategory
+	categories["orphanedSecrets"] = secretCategory
+
+	return map[string]interface{}{
+		"namespace":    namespace,
+		"dryRun":       dryRun,
+		"categories":   categories,
+		"totalDeleted": totalDeleted,
+		"totalFailed":  totalFailed,
+	}, nil
+}
+
+// preservedSecretTypes are never deleted as orphans: TLS and registry secrets are often referenced only by
+// custom resources (certificates, gateways, external secrets), which the reference scan cannot see
+var preservedSecretTypes = map[corev1.SecretType]bool{
+	corev1.SecretTypeTLS:              true,
+	corev1.SecretTypeDockerConfigJson: true,
+	corev1.SecretTypeDockercfg:        true,
+}
+
+// namespaceConfigReferences collects the names of configmaps and secrets referenced by pods, workload templates,
+// service accounts and ingresses in a namespace. Jobs in excludeJobs, and their pods, are ignored.
+func (c *Client) namespaceConfigReferences(ctx context.Context, namespace string, excludeJobs map[string]bool) (map[string]bool, map[string]bool, error) {
+	configMaps := map[string]bool{}
+	secrets := map[string]bool{}
+
+	pods, err := c.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list pods in namespace '%s': %v", namespace, err)
+	}
+	for i := range pods.Items {
+		if owner := metav1.GetControllerOf(&pods.Items[i]); owner != nil && owner.Kind == "Job" && excludeJobs[owner.Name] {
+			continue
+		}
+		collectPodSpecReferences(&pods.Items[i].Spec, configMaps, secrets)
+	}
+
+	// Templates matter too, so workloads scaled to zero keep their configuration
+	deployments, err := c.clientset.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list deployments in namespace '%s': %v", namespace, err)
+	}
+	for i := range deployments.Items {
+		collectPodSpecReferences(&deployments.Items[i].Spec.Template.Spec, configMaps, secrets)
+	}
+
+	// Old ReplicaSets are the rollback targets, so their templates count even at zero replicas
+	replicaSets, err := c.clientset.AppsV1().ReplicaSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list replicasets in namespace '%s': %v", namespace, err)
+	}
+	for i := range replicaSets.Items {
+		collectPodSpecReferences(&replicaSets.Items[i].Spec.Template.Spec, configMaps, secrets)
+	}
+
+	statefulSets, err := c.clientset.AppsV1().StatefulSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list statefulsets in namespace '%s': %v", namespace, err)
+	}
+	for i := range statefulSets.Items {
+		collectPodSpecReferences(&statefulSets.Items[i].Spec.Template.Spec, configMaps, secrets)
+	}
+
+	daemonSets, err := c.clientset.AppsV1().DaemonSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list daemonsets in namespace '%s': %v", namespace, err)
+	}
+	for i := range daemonSets.Items {
+		collectPodSpecReferences(&daemonSets.Items[i].Spec.Template.Spec, configMaps, secrets)
+	}
+
+	// Suspended Jobs and Jobs without a CronJob have no running pods but still need their config
+	jobs, err := c.clientset.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list jobs in namespace '%s': %v", namespace, err)
+	}
+	for i := range jobs.Items {
+		if excludeJobs[jobs.Items[i].Name] {
+			continue
+		}
+		collectPodSpecReferences(&jobs.Items[i].Spec.Template.Spec, configMaps, secrets)
+	}
+
+	cronJobs, err := c.clientset.BatchV1().CronJobs(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list cronjobs in namespace '%s': %v", namespace, err)
+	}
+	for i := range cronJobs.Items {
+		collectPodSpecReferences(&cronJobs.Items[i].Spec.JobTemplate.Spec.Template.Spec, configMaps, secrets)
+	}
+
+	serviceAccounts, err := c.clientset.CoreV1().ServiceAccounts(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list service accounts in namespace '%s': %v", namespace, err)
+	}
+	for _, serviceAccount := range serviceAccounts.Items {
+		for _, ref := range serviceAccount.Secrets {
+			secrets[ref.Name] = true
+		}
+		for _, ref := range serviceAccount.ImagePullSecrets {
+			secrets[ref.Name] = true
+		}
+	}
+
+	ingresses, err := c.clientset.NetworkingV1().Ingresses(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list ingresses in namespace '%s': %v", namespace, err)
+	}
+	for _, ingress := range ingresses.Items {
+		for _, tls := range ingress.Spec.TLS {
+			if tls.SecretName != "" {
+				secrets[tls.SecretName] = true
+			}
+		}
+	}
+
+	return configMaps, secrets, nil
+}
+
+// collectPodSpecReferences records every configmap and secret a pod spec mounts or reads
+func collectPodSpecReferences(spec *corev1.PodSpec, configMaps, secrets map[string]bool) {
+	for _, ref := range spec.ImagePullSecrets {
+		secrets[ref.Name] = true
+	}
+
+	for _, volume := range spec.Volumes {
+		if volume.ConfigMap != nil {
+			configMaps[volume.ConfigMap.Name] = true
+		}
+		if volume.Secret != nil {
+			secrets[volume.Secret.SecretName] = true
+		}
+		if volume.Projected != nil {
+			for _, source := range volume.Projected.Sources {
+				if source.ConfigMap != nil {
+					configMaps[source.ConfigMap.Name] = true
+				}
+				if source.Secret != nil {
+					secrets[source.Secret.Name] = true
+				}
+			}
+		}
+
+		// Volume plugins that authenticate with a secret
+		var secretRef *corev1.LocalObjectReference
+		switch {
+		case volume.CSI != nil:
+			secretRef = volume.CSI.NodePublishSecretRef
+		case volume.CephFS != nil:
+			secretRef = volume.CephFS.SecretRef
+		case volume.RBD != nil:
+			secretRef = volume.RBD.SecretRef
+		case volume.FlexVolume != nil:
+			secretRef = volume.FlexVolume.SecretRef
+		case volume.ISCSI != nil:
+			secretRef = volume.ISCSI.SecretRef
+		case volume.ScaleIO != nil:
+			secretRef = volume.ScaleIO.SecretRef
+		case volume.StorageOS != nil:
+			secretRef = volume.StorageOS.SecretRef
+		case volume.AzureFile != nil:
+			secrets[volume.AzureFile.SecretName] = true
+		}
+		if secretRef != nil && secretRef.Name != "" {
+			secrets[secretRef.Name] = true
+		}
+	}
+
+	for _, container := range spec.InitContainers {
+		collectEnvReferences(container.EnvFrom, container.Env, configMaps, secrets)
+	}
+	for _, container := range spec.Containers {
+		collectEnvReferences(container.EnvFrom, container.Env, configMaps, secrets)
+	}
+	for _, container := range spec.EphemeralContainers {
+		collectEnvReferences(container.EnvFrom, container.Env, configMaps, secrets)
+	}
+}
+
+// collectEnvReferences records the configmaps and secrets a container reads through its environment
+func collectEnvReferences(envFromSources []corev1.EnvFromSource, envVars []corev1.EnvVar, configMaps, secrets map[string]bool) {
+	for _, envFrom := range envFromSources {
+		if envFrom.ConfigMapRef != nil {
+			configMaps[envFrom.ConfigMapRef.Name] = true
+		}
+		if envFrom.SecretRef != nil {
+			secrets[envFrom.SecretRef.Name] = true
+		}
+	}
+	for _, env := range envVars {
+		if env.ValueFrom == nil {
+			continue
+		}
+		if env.ValueFrom.ConfigMapKeyRef != nil {
+			configMaps[env.ValueFrom.ConfigMapKeyRef.Name] = true
+		}
+		if env.ValueFrom.SecretKeyRef != nil {
+			secrets[env.ValueFrom.SecretKeyRef.Name] = true
+		}
+	}
+}
+
 // ========== POD OPERATIONS ==========
 // GetPodsInNamespace returns detailed pod information in the specified namespace
 func (c *Client) GetPodsInNamespace(namespace string) ([]map[string]interface{}, error) {
@@ -963,25 +1545,222 @@ func (c *Client) GetPodLogs(ctx context.Context, namespace, name, containerName
 	return buf.String(), nil
 }
 
-// DeletePod deletes a specific pod
-func (c *Client) DeletePod(ctx context.Context, namespace, name string, gracePeriodSeconds int64) error {
-	deleteOptions := metav1.DeleteOptions{}
-	if gracePeriodSeconds > 0 {
-		deleteOptions.GracePeriodSeconds = &gracePeriodSeconds
+// maxRangeLogBytes caps how much log GetPodLogsInRange reads before filtering, so chatty pods cannot exhaust memory
+const maxRangeLogBytes int64 = 10 * 1024 * 1024
+
+// GetPodLogsInRange retrieves pod logs and keeps only lines whose API timestamp falls within [from, to].
+// A zero from or to leaves that side of the window open; from is also sent as sinceTime to limit what the API returns.
+// At most maxRangeLogBytes are read, and the result reports when the window was cut short.
+func (c *Client) GetPodLogsInRange(ctx context.Context, namespace, name, containerName string, tailLines int64, previous bool, from, to time.Time) (map[string]interface{}, error) {
+	limitBytes := maxRangeLogBytes
+	logOptions := &corev1.PodLogOptions{
+		Previous:   previous,
+		Timestamps: true,
+		LimitBytes: &limitBytes,
+	}
+	if tailLines > 0 {
+		logOptions.TailLines = &tailLines
+	}
+	if !from.IsZero() {
+		sinceTime := metav1.NewTime(from)
+		logOptions.SinceTime = &sinceTime
+	}
+	if containerName != "" {
+		logOptions.Container = containerName
 	}
 
-	err := c.clientset.CoreV1().Pods(namespace).Delete(ctx, name, deleteOptions)
+	req := c.clientset.CoreV1().Pods(namespace).GetLogs(name, logOptions)
+	logs, err := req.Stream(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to delete pod '%s' in namespace '%s': %v", name, namespace, err)
+		return nil, fmt.Errorf("failed to get logs for pod '%s' in namespace '%s': %v", name, namespace, err)
 	}
+	defer logs.Close()
 
-	return nil
-}
+	// The server usually enforces LimitBytes, but the reader is bounded too in case it does not
+	buf := new(bytes.Buffer)
+	if _, err := io.Copy(buf, io.LimitReader(logs, limitBytes)); err != nil {
+		return nil, fmt.Errorf("failed to read logs: %v", err)
+	}
 
-// GetPodEvents retrieves events related to a specific pod
-func (c *Client) GetPodEvents(ctx context.Context, namespace, podName string) ([]map[string]interface{}, error) {
-	events, err := c.clientset.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
-		FieldSelector: fmt.Sprintf("involvedObject.name=%s,involvedObject.kind=Pod", podName),
+	content := buf.String()
+	truncated := int64(buf.Len()) >= limitBytes
+	if truncated {
+		// The cut usually lands mid-line; drop the partial line
+		if i := strings.LastIndex(content, "\n"); i >= 0 {
+			content = content[:i]
+		}
+	}
+
+	var matched []string
+	totalLines := 0
+	unparsed := 0
+	include := false
+	for _, line := range strings.Split(strings.TrimRight(content, "\n"), "\n") {
+		if line == "" {
+			continue
+		}
+		totalLines++
+
+		// Lines are prefixed with an RFC3339Nano timestamp; anything unparsable follows the previous line's decision
+		if timestamp, _, found := strings.Cut(line, " "); found {
+			if t, err := time.Parse(time.RFC3339Nano, timestamp); err == nil {
+				include = (from.IsZero() || !t.Before(from)) && (to.IsZero() || !t.After(to))
+			} else {
+				unparsed++
+			}
+		} else {
+			unparsed++
+		}
+
+		if include {
+			matched = append(matched, line)
+		}
+	}
+
+	window := map[string]interface{}{}
+	if !from.IsZero() {
+		window["from"] = from.Format(time.RFC3339Nano)
+	}
+	if !to.IsZero() {
+		window["to"] = to.Format(time.RFC3339Nano)
+	}
+
+	result := map[string]interface{}{
+		"logs":         strings.Join(matched, "\n"),
+		"matchedLines": len(matched),
+		"totalLines":   totalLines,
+		"window":       window,
+	}
+	if unparsed > 0 {
+		result["unparsedLines"] = unparsed
+	}
+	if truncated {
+		result["truncated"] = true
+		result["limitBytes"] = limitBytes
+		result["message"] = fmt.Sprintf("Only the first %d bytes of the log were read; narrow the window with 'from' or 'tailLines' to see later lines", limitBytes)
+	}
+
+	return result, nil
+}
+
+// DeletePod deletes a specific pod
+func (c *Client) DeletePod(ctx context.Context, namespace, name string, gracePeriodSeconds int64) error {
+	deleteOptions := metav1.DeleteOptions{}
+	if gracePeriodSeconds > 0 {
+		deleteOptions.GracePeriodSeconds = &gracePeriodSeconds
+	}
+
+	err := c.clientset.CoreV1().Pods(namespace).Delete(ctx, name, deleteOptions)
+	if err != nil {
+		return fmt.Errorf("failed to delete pod '%s' in namespace '%s': %v", name, namespace, err)
+	}
+
+	return nil
+}
+
+// EvictPod evicts a pod through the policy/v1 Eviction API so PodDisruptionBudgets are respected
+// A nil gracePeriodSeconds keeps the pod's own terminationGracePeriodSeconds.
+func (c *Client) EvictPod(ctx context.Context, namespace, name string, gracePeriodSeconds *int64, dryRun bool) (map[string]interface{}, error) {
+	pod, err := c.clientset.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pod '%s' in namespace '%s': %v", name, namespace, err)
+	}
+
+	deleteOptions := &metav1.DeleteOptions{GracePeriodSeconds: gracePeriodSeconds}
+	if dryRun {
+		deleteOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
+	eviction := &policyv1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		DeleteOptions: deleteOptions,
+	}
+
+	result := map[string]interface{}{
+		"podName":   name,
+		"namespace": namespace,
+		"nodeName":  pod.Spec.NodeName,
+		"dryRun":    dryRun,
+	}
+
+	err = c.clientset.CoreV1().Pods(namespace).EvictV1(ctx, eviction)
+	if err == nil {
+		result["allowed"] = true
+		result["status"] = "evicted"
+		if dryRun {
+			result["status"] = "would be evicted"
+		}
+		return result, nil
+	}
+
+	// 429 means a disruption budget blocked the eviction, 500 means several budgets select the pod
+	if !apierrors.IsTooManyRequests(err) && !apierrors.IsInternalError(err) {
+		return nil, fmt.Errorf("failed to evict pod '%s' in namespace '%s': %v", name, namespace, err)
+	}
+
+	blocking, pdbErr := c.findPodDisruptionBudgets(ctx, pod)
+	if apierrors.IsInternalError(err) && len(blocking) < 2 {
+		return nil, fmt.Errorf("failed to evict pod '%s' in namespace '%s': %v", name, namespace, err)
+	}
+	if pdbErr != nil {
+		result["pdbLookupError"] = pdbErr.Error()
+	}
+
+	result["allowed"] = false
+	result["status"] = "blocked"
+	result["reason"] = err.Error()
+	result["blockingPDBs"] = blocking
+	for _, pdb := range blocking {
+		// Report the budget that is actually out of disruptions, not just the first one selecting the pod
+		if allowed, _ := pdb["disruptionsAllowed"].(int32); allowed == 0 {
+			result["pdbName"] = pdb["name"]
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// findPodDisruptionBudgets returns the PodDisruptionBudgets whose selector matches the pod
+func (c *Client) findPodDisruptionBudgets(ctx context.Context, pod *corev1.Pod) ([]map[string]interface{}, error) {
+	pdbs, err := c.clientset.PolicyV1().PodDisruptionBudgets(pod.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pod disruption budgets in namespace '%s': %v", pod.Namespace, err)
+	}
+
+	matching := []map[string]interface{}{}
+	for _, pdb := range pdbs.Items {
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil || selector.Empty() || !selector.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+
+		pdbInfo := map[string]interface{}{
+			"name":               pdb.Name,
+			"disruptionsAllowed": pdb.Status.DisruptionsAllowed,
+			"currentHealthy":     pdb.Status.CurrentHealthy,
+			"desiredHealthy":     pdb.Status.DesiredHealthy,
+			"expectedPods":       pdb.Status.ExpectedPods,
+		}
+		if pdb.Spec.MinAvailable != nil {
+			pdbInfo["minAvailable"] = pdb.Spec.MinAvailable.String()
+		}
+		if pdb.Spec.MaxUnavailable != nil {
+			pdbInfo["maxUnavailable"] = pdb.Spec.MaxUnavailable.String()
+		}
+		matching = append(matching, pdbInfo)
+	}
+
+	return matching, nil
+}
+
+// GetPodEvents retrieves events related to a specific pod
+func (c *Client) GetPodEvents(ctx context.Context, namespace, podName string) ([]map[string]interface{}, error) {
+	events, err := c.clientset.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("involvedObject.name=%s,involvedObject.kind=Pod", podName),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get events for pod '%s': %v", podName, err)
@@ -1135,6 +1914,144 @@ func (c *Client) CreatePod(ctx context.Context, namespace string, podManifest st
 	return result, nil
 }
 
+// WaitForPodScheduled polls a pod until it is assigned to a node, reported unschedulable, or the timeout expires
+func (c *Client) WaitForPodScheduled(ctx context.Context, namespace, name string, timeoutSeconds int) (map[string]interface{}, error) {
+	if timeoutSeconds <= 0 {
+		timeoutSeconds = 30
+	}
+
+	waited, err := c.WaitForPodCondition(ctx, namespace, name, string(corev1.PodScheduled), string(corev1.ConditionTrue), timeoutSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeName, _ := waited["nodeName"].(string)
+	result := map[string]interface{}{
+		"scheduled": waited["met"],
+		"nodeName":  nodeName,
+		"waitTime":  waited["waitTime"],
+	}
+	if phase, exists := waited["phase"]; exists {
+		result["phase"] = phase
+	}
+	if reason, exists := waited["reason"]; exists {
+		result["reason"] = reason
+		result["message"] = waited["message"]
+		if reason == "Timeout" {
+			result["message"] = fmt.Sprintf("Pod '%s' was not scheduled within %d seconds", name, timeoutSeconds)
+		}
+	}
+	return result, nil
+}
+
+// WaitForPodCondition polls a pod until the given condition reaches the desired status or the timeout expires
+func (c *Client) WaitForPodCondition(ctx context.Context, namespace, name, conditionType, status string, timeoutSeconds int) (map[string]interface{}, error) {
+	if namespace == "" {
+		namespace = "default"
+	}
+	if timeoutSeconds <= 0 {
+		timeoutSeconds = 60
+	}
+
+	// Accept the built-in condition types case-insensitively; anything else is treated as a readiness gate
+	wantType := corev1.PodConditionType(conditionType)
+	for _, known := range []corev1.PodConditionType{corev1.PodReady, corev1.PodInitialized, corev1.ContainersReady, corev1.PodScheduled} {
+		if strings.EqualFold(conditionType, string(known)) {
+			wantType = known
+			break
+		}
+	}
+
+	var wantStatus corev1.ConditionStatus
+	switch strings.ToLower(status) {
+	case "", "true":
+		wantStatus = corev1.ConditionTrue
+	case "false":
+		wantStatus = corev1.ConditionFalse
+	case "unknown":
+		wantStatus = corev1.ConditionUnknown
+	default:
+		return nil, fmt.Errorf("invalid status '%s': must be 'True', 'False' or 'Unknown'", status)
+	}
+
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	var lastPod *corev1.Pod
+	buildResult := func(met bool, reason, message string) map[string]interface{} {
+		result := map[string]interface{}{
+			"podName":        name,
+			"namespace":      namespace,
+			"conditionType":  string(wantType),
+			"desiredStatus":  string(wantStatus),
+			"met":            met,
+			"waitTime":       time.Since(start).Round(time.Millisecond).String(),
+			"condition":      nil,
+			"timeoutSeconds": timeoutSeconds,
+		}
+		if reason != "" {
+			result["reason"] = reason
+			result["message"] = message
+		}
+		if lastPod != nil {
+			result["phase"] = string(lastPod.Status.Phase)
+			if lastPod.Spec.NodeName != "" {
+				result["nodeName"] = lastPod.Spec.NodeName
+			}
+			for _, condition := range lastPod.Status.Conditions {
+				if condition.Type == wantType {
+					result["condition"] = map[string]interface{}{
+						"type":               string(condition.Type),
+						"status":             string(condition.Status),
+						"reason":             condition.Reason,
+						"message":            condition.Message,
+						"lastTransitionTime": condition.LastTransitionTime.Time,
+					}
+					break
+				}
+			}
+		}
+		return result
+	}
+
+	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		pod, err := c.clientset.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if ctx.Err() != nil {
+				return buildResult(false, "Timeout", fmt.Sprintf("Condition %s=%s not reached within %d seconds", wantType, wantStatus, timeoutSeconds)), nil
+			}
+			return nil, fmt.Errorf("failed to get pod '%s' in namespace '%s': %v", name, namespace, err)
+		}
+		lastPod = pod
+
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == wantType && condition.Status == wantStatus {
+				return buildResult(true, "", ""), nil
+			}
+			// Stop early when waiting for scheduling and the scheduler has already reported the pod as unschedulable
+			if wantType == corev1.PodScheduled && wantStatus == corev1.ConditionTrue &&
+				condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionFalse && condition.Reason != "" {
+				return buildResult(false, condition.Reason, condition.Message), nil
+			}
+		}
+
+		// A finished pod will not change its conditions any more
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			return buildResult(false, "PodTerminated", fmt.Sprintf("Pod finished with phase %s before condition %s=%s was reached", pod.Status.Phase, wantType, wantStatus)), nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return buildResult(false, "Timeout", fmt.Sprintf("Condition %s=%s not reached within %d seconds", wantType, wantStatus, timeoutSeconds)), nil
+		case <-ticker.C:
+		}
+	}
+}
+
 // UpdatePod updates an existing pod (limited to labels and annotations)
 func (c *Client) UpdatePod(ctx context.Context, namespace, name string, labels, annotations map[string]string) (map[string]interface{}, error) {
 	// Get the current pod
@@ -1381,6 +2298,10 @@ func (c *Client) CreateDeployment(ctx context.Context, manifest string, namespac
 		deployment.Spec.Replicas = &replicas
 	}
 
+	if err := c.checkReplicaLimit("deployment", deployment.Name, *deployment.Spec.Replicas); err != nil {
+		return nil, err
+	}
+
 	createdDeployment, err := c.clientset.AppsV1().Deployments(namespace).Create(ctx, &deployment, metav1.CreateOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create deployment '%s' in namespace '%s': %v", deployment.Name, namespace, err)
@@ -1414,6 +2335,14 @@ func (c *Client) UpdateDeployment(ctx context.Context, name, manifest, namespace
 	updatedDeployment.ResourceVersion = existingDeployment.ResourceVersion
 	updatedDeployment.UID = existingDeployment.UID
 
+	// Only a replica change is a scale operation; unrelated edits to a deployment already above the limit must still work
+	if updatedDeployment.Spec.Replicas != nil &&
+		(existingDeployment.Spec.Replicas == nil || *updatedDeployment.Spec.Replicas != *existingDeployment.Spec.Replicas) {
+		if err := c.checkReplicaLimit("deployment", name, *updatedDeployment.Spec.Replicas); err != nil {
+			return nil, err
+		}
+	}
+
 	result, err := c.clientset.AppsV1().Deployments(namespace).Update(ctx, &updatedDeployment, metav1.UpdateOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update deployment '%s' in namespace '%s': %v", name, namespace, err)
@@ -1445,6 +2374,20 @@ func (c *Client) DeleteDeployment(ctx context.Context, name, namespace string, c
 	return nil
 }
 
+// SetMaxReplicas sets the highest replica count a scale request may ask for (0 disables the limit)
+func (c *Client) SetMaxReplicas(maxReplicas int32) {
+	c.maxReplicas = maxReplicas
+}
+
+// checkReplicaLimit rejects replica counts above the configured guardrail
+func (c *Client) checkReplicaLimit(kind, name string, replicas int32) error {
+	if c.maxReplicas > 0 && replicas > c.maxReplicas {
+		log.Printf("⛔ Rejected scaling %s '%s' to %d replicas (server limit is %d)", kind, name, replicas, c.maxReplicas)
+		return fmt.Errorf("requested %d replicas for %s '%s' exceeds the server limit of %d (set by --max-replicas)", replicas, kind, name, c.maxReplicas)
+	}
+	return nil
+}
+
 // ScaleDeployment scales a deployment to the specified number of replicas
 func (c *Client) ScaleDeployment(ctx context.Context, name, namespace string, replicas int32) (*appsv1.Deployment, error) {
 	if namespace == "" {
@@ -1457,6 +2400,10 @@ func (c *Client) ScaleDeployment(ctx context.Context, name, namespace string, re
 		return nil, fmt.Errorf("failed to get deployment '%s': %v", name, err)
 	}
 
+	if err := c.checkReplicaLimit("deployment", name, replicas); err != nil {
+		return nil, err
+	}
+
 	// Update the replica count
 	deployment.Spec.Replicas = &replicas
 
@@ -1495,19 +2442,28 @@ func (c *Client) GetRolloutStatus(ctx context.Context, name, namespace string) (
 	}
 
 	// Determine rollout status
+	status["rolloutStatus"], _ = rolloutProgress(deployment)
+
+	return status, nil
+}
+
+// rolloutProgress describes where a deployment rollout stands and whether it has completed
+func rolloutProgress(deployment *appsv1.Deployment) (string, bool) {
+	desired := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+
 	if deployment.Generation > deployment.Status.ObservedGeneration {
-		status["rolloutStatus"] = "Waiting for rollout to finish"
-	} else if deployment.Status.UpdatedReplicas < *deployment.Spec.Replicas {
-		status["rolloutStatus"] = "Waiting for deployment to update"
+		return "Waiting for rollout to finish", false
+	} else if deployment.Status.UpdatedReplicas < desired {
+		return "Waiting for deployment to update", false
 	} else if deployment.Status.Replicas > deployment.Status.UpdatedReplicas {
-		status["rolloutStatus"] = "Waiting for old replica sets to terminate"
+		return "Waiting for old replica sets to terminate", false
 	} else if deployment.Status.AvailableReplicas < deployment.Status.UpdatedReplicas {
-		status["rolloutStatus"] = "Waiting for deployment to become available"
-	} else {
-		status["rolloutStatus"] = "Successfully rolled out"
+		return "Waiting for deployment to become available", false
 	}
-
-	return status, nil
+	return "Successfully rolled out", true
 }
 
 // GetRolloutHistory returns the rollout history of a deployment
@@ -1840,6 +2796,88 @@ func (c *Client) RestartDeployment(ctx context.Context, name, namespace string)
 	return result, nil
 }
 
+// TriggerReloadByLabel sets the restart annotation on every deployment and statefulset matching a label selector,
+// using one timestamp so the whole group rolls as a single coordinated restart
+func (c *Client) TriggerReloadByLabel(ctx context.Context, namespace, labelSelector string, dryRun bool) (map[string]interface{}, error) {
+	if namespace == "" {
+		namespace = "default"
+	}
+	if strings.TrimSpace(labelSelector) == "" {
+		return nil, fmt.Errorf("labelSelector is required (refusing to restart every workload in the namespace)")
+	}
+	if _, err := labels.Parse(labelSelector); err != nil {
+		return nil, fmt.Errorf("invalid label selector '%s': %v", labelSelector, err)
+	}
+
+	listOptions := metav1.ListOptions{LabelSelector: labelSelector}
+	deployments, err := c.clientset.AppsV1().Deployments(namespace).List(ctx, listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployments in namespace '%s': %v", namespace, err)
+	}
+	statefulSets, err := c.clientset.AppsV1().StatefulSets(namespace).List(ctx, listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list statefulsets in namespace '%s': %v", namespace, err)
+	}
+
+	restartedAt := time.Now().Format(time.RFC3339)
+	patch := []byte(fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{"kubectl.kubernetes.io/restartedAt":%q}}}}}`, restartedAt))
+	patchOptions := metav1.PatchOptions{}
+	if dryRun {
+		patchOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
+	workloads := []map[string]interface{}{}
+	triggered := 0
+	failed := 0
+	record := func(kind, name string, paused bool, err error) {
+		workload := map[string]interface{}{
+			"kind": kind,
+			"name": name,
+		}
+		switch {
+		case err != nil:
+			workload["status"] = "failed"
+			workload["error"] = err.Error()
+			failed++
+		case dryRun:
+			workload["status"] = "dry-run"
+			triggered++
+		default:
+			workload["status"] = "triggered"
+			triggered++
+		}
+		if paused {
+			workload["warning"] = "Deployment is paused - the restart will roll out only after it is resumed"
+		}
+		workloads = append(workloads, workload)
+	}
+
+	for _, deployment := range deployments.Items {
+		_, err := c.clientset.AppsV1().Deployments(namespace).Patch(ctx, deployment.Name, types.StrategicMergePatchType, patch, patchOptions)
+		record("Deployment", deployment.Name, deployment.Spec.Paused, err)
+	}
+	for _, statefulSet := range statefulSets.Items {
+		_, err := c.clientset.AppsV1().StatefulSets(namespace).Patch(ctx, statefulSet.Name, types.StrategicMergePatchType, patch, patchOptions)
+		record("StatefulSet", statefulSet.Name, false, err)
+	}
+
+	result := map[string]interface{}{
+		"namespace":     namespace,
+		"labelSelector": labelSelector,
+		"dryRun":        dryRun,
+		"restartedAt":   restartedAt,
+		"workloads":     workloads,
+		"matched":       len(workloads),
+		"triggered":     triggered,
+		"failed":        failed,
+	}
+	if len(workloads) == 0 {
+		result["message"] = fmt.Sprintf("No deployments or statefulsets match '%s' in namespace '%s'", labelSelector, namespace)
+	}
+
+	return result, nil
+}
+
 // WaitForDeployment waits for a deployment to reach its desired state
 func (c *Client) WaitForDeployment(ctx context.Context, name, namespace string, timeoutSeconds int) (map[string]interface{}, error) {
 	if namespace == "" {
@@ -1884,57 +2922,465 @@ func (c *Client) WaitForDeployment(ctx context.Context, name, namespace string,
 	}
 }
 
-// SetDeploymentImage updates the image of a specific container in a deployment
-func (c *Client) SetDeploymentImage(ctx context.Context, name, namespace, container, image string) (*appsv1.Deployment, error) {
+// rolloutPodState tracks what has already been reported about a pod during watchRollout
+type rolloutPodState struct {
+	ready         bool
+	terminating   bool
+	waitingReason string
+}
+
+// WatchRollout follows a deployment rollout with watches and reports each step through onEvent until it completes, fails or times out
+func (c *Client) WatchRollout(ctx context.Context, name, namespace string, timeoutSeconds int, onEvent func(event map[string]interface{})) (map[string]interface{}, error) {
 	if namespace == "" {
 		namespace = "default"
 	}
+	if timeoutSeconds <= 0 {
+		timeoutSeconds = 300
+	}
+
+	watchCtx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
 
-	// Get current deployment
 	deployment, err := c.clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get deployment '%s': %v", name, err)
 	}
 
-	// Find and update the container image
-	found := false
-	for i, c := range deployment.Spec.Template.Spec.Containers {
-		if c.Name == container {
-			deployment.Spec.Template.Spec.Containers[i].Image = image
-			found = true
-			break
-		}
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector on deployment '%s': %v", name, err)
 	}
 
-	if !found {
-		return nil, fmt.Errorf("container '%s' not found in deployment '%s'", container, name)
+	podList, err := c.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods for deployment '%s': %v", name, err)
 	}
 
-	// Update change cause annotation
-	if deployment.Annotations == nil {
-		deployment.Annotations = make(map[string]string)
+	start := time.Now()
+	var events []map[string]interface{}
+	emit := func(eventType, message string, details map[string]interface{}) {
+		event := map[string]interface{}{
+			"type":    eventType,
+			"message": message,
+			"elapsed": time.Since(start).Round(time.Second).String(),
+		}
+		for key, value := range details {
+			event[key] = value
+		}
+		events = append(events, event)
+		if onEvent != nil {
+			onEvent(event)
+		}
 	}
-	deployment.Annotations["deployment.kubernetes.io/change-cause"] = fmt.Sprintf("Updated image for container '%s' to '%s'", container, image)
 
-	// Update deployment
-	result, err := c.clientset.AppsV1().Deployments(namespace).Update(ctx, deployment, metav1.UpdateOptions{})
-	if err != nil {
-		return nil, fmt.Errorf("failed to update deployment image: %v", err)
+	newHash := c.currentPodTemplateHash(ctx, deployment)
+	checkedHashes := map[string]bool{newHash: true}
+	podStates := map[string]*rolloutPodState{}
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		checkedHashes[pod.Labels["pod-template-hash"]] = true
+		reason, _ := podWaitingReason(pod)
+		podStates[pod.Name] = &rolloutPodState{
+			ready:         isPodReady(pod),
+			terminating:   pod.DeletionTimestamp != nil,
+			waitingReason: reason,
+		}
 	}
 
-	return result, nil
-}
+	finish := func(status string) map[string]interface{} {
+		progress, _ := rolloutProgress(deployment)
+		result := map[string]interface{}{
+			"deployment":        name,
+			"namespace":         namespace,
+			"status":            status,
+			"rolloutStatus":     progress,
+			"duration":          time.Since(start).Round(time.Second).String(),
+			"replicas":          deployment.Status.Replicas,
+			"updatedReplicas":   deployment.Status.UpdatedReplicas,
+			"readyReplicas":     deployment.Status.ReadyReplicas,
+			"availableReplicas": deployment.Status.AvailableReplicas,
+			"events":            events,
+			"eventCount":        len(events),
+		}
+		if status == "failed" || status == "timeout" {
+			if blockers, err := c.deploymentPodBlockers(ctx, deployment); err == nil {
+				result["blockers"] = blockers
+			}
+		}
+		return result
+	}
 
-// SetDeploymentEnv updates environment variables in a deployment
-func (c *Client) SetDeploymentEnv(ctx context.Context, name, namespace, container string, envVars map[string]string) (*appsv1.Deployment, error) {
-	if namespace == "" {
-		namespace = "default"
+	timedOut := func() map[string]interface{} {
+		emit("timeout", fmt.Sprintf("Rollout of deployment '%s' did not finish within %ds", name, timeoutSeconds), nil)
+		return finish("timeout")
 	}
 
-	// Get current deployment
-	deployment, err := c.clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	lastProgress, done := rolloutProgress(deployment)
+	emit("progress", lastProgress, rolloutCounts(deployment))
+	if done {
+		return finish("complete"), nil
+	}
+	if deployment.Spec.Paused {
+		emit("paused", fmt.Sprintf("Deployment '%s' is paused; resume it to continue the rollout", name), nil)
+		return finish("paused"), nil
+	}
+
+	podWatch, err := c.clientset.CoreV1().Pods(namespace).Watch(watchCtx, metav1.ListOptions{
+		LabelSelector:   selector.String(),
+		ResourceVersion: podList.ResourceVersion,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get deployment '%s': %v", name, err)
+		return nil, fmt.Errorf("failed to watch pods for deployment '%s': %v", name, err)
+	}
+	defer func() { podWatch.Stop() }()
+	podResourceVersion := podList.ResourceVersion
+
+	deploymentWatch, err := c.clientset.AppsV1().Deployments(namespace).Watch(watchCtx, metav1.ListOptions{
+		FieldSelector:   fmt.Sprintf("metadata.name=%s", name),
+		ResourceVersion: deployment.ResourceVersion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch deployment '%s': %v", name, err)
+	}
+	defer func() { deploymentWatch.Stop() }()
+
+	observePod := func(eventType watch.EventType, pod *corev1.Pod) {
+		// A hash not seen before may belong to a replica set created after the watch started
+		hash := pod.Labels["pod-template-hash"]
+		if hash != newHash && !checkedHashes[hash] {
+			checkedHashes[hash] = true
+			newHash = c.currentPodTemplateHash(watchCtx, deployment)
+		}
+		revision := "old"
+		if hash == newHash {
+			revision = "new"
+		}
+		details := map[string]interface{}{"pod": pod.Name, "revision": revision}
+
+		state, seen := podStates[pod.Name]
+		if eventType == watch.Deleted {
+			if seen {
+				delete(podStates, pod.Name)
+				emit("podDeleted", fmt.Sprintf("Pod '%s' (%s) terminated", pod.Name, revision), details)
+			}
+			return
+		}
+		if !seen {
+			state = &rolloutPodState{}
+			podStates[pod.Name] = state
+			emit("podCreated", fmt.Sprintf("Pod '%s' (%s) created", pod.Name, revision), details)
+		}
+		if pod.DeletionTimestamp != nil && !state.terminating {
+			state.terminating = true
+			emit("podTerminating", fmt.Sprintf("Pod '%s' (%s) is terminating", pod.Name, revision), details)
+		}
+		if ready := isPodReady(pod); ready != state.ready {
+			state.ready = ready
+			if ready {
+				emit("podReady", fmt.Sprintf("Pod '%s' (%s) is ready", pod.Name, revision), details)
+			} else if pod.DeletionTimestamp == nil {
+				emit("podNotReady", fmt.Sprintf("Pod '%s' (%s) is no longer ready", pod.Name, revision), details)
+			}
+		}
+		if reason, message := podWaitingReason(pod); reason != state.waitingReason {
+			state.waitingReason = reason
+			if reason != "" {
+				details["reason"] = reason
+				details["detail"] = message
+				emit("podWaiting", fmt.Sprintf("Pod '%s' (%s) is waiting: %s", pod.Name, revision, reason), details)
+			}
+		}
+	}
+
+	// resyncPods re-lists the pods after the watch history expired and reports what changed during the gap
+	resyncPods := func() error {
+		current, err := c.clientset.CoreV1().Pods(namespace).List(watchCtx, metav1.ListOptions{LabelSelector: selector.String()})
+		if err != nil {
+			return err
+		}
+		listed := map[string]bool{}
+		for i := range current.Items {
+			listed[current.Items[i].Name] = true
+			observePod(watch.Modified, &current.Items[i])
+		}
+		for podName := range podStates {
+			if !listed[podName] {
+				delete(podStates, podName)
+				emit("podDeleted", fmt.Sprintf("Pod '%s' terminated", podName), map[string]interface{}{"pod": podName})
+			}
+		}
+		podResourceVersion = current.ResourceVersion
+		return nil
+	}
+
+	// observeDeployment records a new deployment state and returns the final result once the rollout has ended
+	observeDeployment := func(updated *appsv1.Deployment) map[string]interface{} {
+		deployment = updated
+
+		progress, done := rolloutProgress(deployment)
+		if progress != lastProgress {
+			lastProgress = progress
+			emit("progress", progress, rolloutCounts(deployment))
+		}
+		if done {
+			emit("complete", fmt.Sprintf("Deployment '%s' successfully rolled out", name), nil)
+			return finish("complete")
+		}
+
+		for _, condition := range deployment.Status.Conditions {
+			if condition.Type == appsv1.DeploymentProgressing && condition.Reason == "ProgressDeadlineExceeded" {
+				emit("failed", fmt.Sprintf("Rollout of deployment '%s' exceeded its progress deadline: %s", name, condition.Message), nil)
+				return finish("failed")
+			}
+			if condition.Type == appsv1.DeploymentReplicaFailure && condition.Status == corev1.ConditionTrue {
+				emit("blocked", fmt.Sprintf("Replica creation is failing: %s", condition.Message), map[string]interface{}{"reason": condition.Reason})
+			}
+		}
+		return nil
+	}
+
+	for {
+		select {
+		case <-watchCtx.Done():
+			return timedOut(), nil
+
+		case event, ok := <-podWatch.ResultChan():
+			if !ok {
+				// The API server closes watches periodically (and on timeout); resume from the last event seen
+				if watchCtx.Err() != nil {
+					return timedOut(), nil
+				}
+				renewed, err := c.clientset.CoreV1().Pods(namespace).Watch(watchCtx, metav1.ListOptions{
+					LabelSelector:   selector.String(),
+					ResourceVersion: podResourceVersion,
+				})
+				if apierrors.IsResourceExpired(err) || apierrors.IsGone(err) {
+					if err = resyncPods(); err == nil {
+						renewed, err = c.clientset.CoreV1().Pods(namespace).Watch(watchCtx, metav1.ListOptions{
+							LabelSelector:   selector.String(),
+							ResourceVersion: podResourceVersion,
+						})
+					}
+				}
+				if err != nil {
+					if watchCtx.Err() != nil {
+						return timedOut(), nil
+					}
+					return nil, fmt.Errorf("failed to re-establish pod watch for deployment '%s': %v", name, err)
+				}
+				podWatch = renewed
+				continue
+			}
+
+			switch event.Type {
+			case watch.Bookmark:
+				continue
+			case watch.Error:
+				// An expired resourceVersion arrives as an error event before the channel closes; re-list so the
+				// next watch starts from a current version and pods removed in the meantime are still reported
+				err := apierrors.FromObject(event.Object)
+				if apierrors.IsResourceExpired(err) || apierrors.IsGone(err) {
+					if err := resyncPods(); err != nil && watchCtx.Err() == nil {
+						return nil, fmt.Errorf("failed to re-list pods for deployment '%s': %v", name, err)
+					}
+				}
+				continue
+			}
+
+			pod, ok := event.Object.(*corev1.Pod)
+			if !ok {
+				continue
+			}
+			podResourceVersion = pod.ResourceVersion
+			observePod(event.Type, pod)
+
+		case event, ok := <-deploymentWatch.ResultChan():
+			if !ok {
+				if watchCtx.Err() != nil {
+					return timedOut(), nil
+				}
+				renewed, err := c.clientset.AppsV1().Deployments(namespace).Watch(watchCtx, metav1.ListOptions{
+					FieldSelector:   fmt.Sprintf("metadata.name=%s", name),
+					ResourceVersion: deployment.ResourceVersion,
+				})
+				if apierrors.IsResourceExpired(err) || apierrors.IsGone(err) {
+					renewed, err = c.clientset.AppsV1().Deployments(namespace).Watch(watchCtx, metav1.ListOptions{
+						FieldSelector: fmt.Sprintf("metadata.name=%s", name),
+					})
+				}
+				if err != nil {
+					if watchCtx.Err() != nil {
+						return timedOut(), nil
+					}
+					return nil, fmt.Errorf("failed to re-establish watch on deployment '%s': %v", name, err)
+				}
+				deploymentWatch = renewed
+				continue
+			}
+
+			switch event.Type {
+			case watch.Bookmark:
+				continue
+			case watch.Error:
+				// Refresh the deployment so the next watch resumes from a version the server still has
+				err := apierrors.FromObject(event.Object)
+				if apierrors.IsResourceExpired(err) || apierrors.IsGone(err) {
+					current, err := c.clientset.AppsV1().Deployments(namespace).Get(watchCtx, name, metav1.GetOptions{})
+					if apierrors.IsNotFound(err) {
+						emit("failed", fmt.Sprintf("Deployment '%s' was deleted during the rollout", name), nil)
+						return finish("deleted"), nil
+					}
+					if err != nil {
+						if watchCtx.Err() != nil {
+							return timedOut(), nil
+						}
+						return nil, fmt.Errorf("failed to get deployment '%s': %v", name, err)
+					}
+					if result := observeDeployment(current); result != nil {
+						return result, nil
+					}
+				}
+				continue
+			}
+
+			updated, ok := event.Object.(*appsv1.Deployment)
+			if !ok {
+				continue
+			}
+			if event.Type == watch.Deleted {
+				emit("failed", fmt.Sprintf("Deployment '%s' was deleted during the rollout", name), nil)
+				return finish("deleted"), nil
+			}
+			if result := observeDeployment(updated); result != nil {
+				return result, nil
+			}
+		}
+	}
+}
+
+// rolloutCounts returns the replica counters reported with each rollout progress event
+func rolloutCounts(deployment *appsv1.Deployment) map[string]interface{} {
+	desired := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+	return map[string]interface{}{
+		"desiredReplicas":   desired,
+		"updatedReplicas":   deployment.Status.UpdatedReplicas,
+		"readyReplicas":     deployment.Status.ReadyReplicas,
+		"availableReplicas": deployment.Status.AvailableReplicas,
+		"totalReplicas":     deployment.Status.Replicas,
+	}
+}
+
+// currentPodTemplateHash returns the pod-template-hash of the deployment's newest replica set, or "" if it cannot be determined
+func (c *Client) currentPodTemplateHash(ctx context.Context, deployment *appsv1.Deployment) string {
+	replicaSets, err := c.clientset.AppsV1().ReplicaSets(deployment.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(deployment.Spec.Selector),
+	})
+	if err != nil {
+		return ""
+	}
+
+	hash := ""
+	highest := int64(-1)
+	for _, rs := range replicaSets.Items {
+		owned := false
+		for _, owner := range rs.OwnerReferences {
+			if owner.UID == deployment.UID {
+				owned = true
+				break
+			}
+		}
+		if !owned {
+			continue
+		}
+		revision, err := strconv.ParseInt(rs.Annotations["deployment.kubernetes.io/revision"], 10, 64)
+		if err != nil {
+			continue
+		}
+		if revision > highest {
+			highest = revision
+			hash = rs.Labels["pod-template-hash"]
+		}
+	}
+	return hash
+}
+
+// podWaitingReason returns why a pod is stuck (unschedulable or a waiting container), ignoring normal start-up states
+func podWaitingReason(pod *corev1.Pod) (string, string) {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionFalse {
+			return condition.Reason, condition.Message
+		}
+	}
+
+	statuses := append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...)
+	statuses = append(statuses, pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		if status.State.Waiting == nil {
+			continue
+		}
+		switch status.State.Waiting.Reason {
+		case "", "ContainerCreating", "PodInitializing":
+			continue
+		}
+		return status.State.Waiting.Reason, status.State.Waiting.Message
+	}
+	return "", ""
+}
+
+// SetDeploymentImage updates the image of a specific container in a deployment
+func (c *Client) SetDeploymentImage(ctx context.Context, name, namespace, container, image string) (*appsv1.Deployment, error) {
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	// Get current deployment
+	deployment, err := c.clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deployment '%s': %v", name, err)
+	}
+
+	// Find and update the container image
+	found := false
+	for i, c := range deployment.Spec.Template.Spec.Containers {
+		if c.Name == container {
+			deployment.Spec.Template.Spec.Containers[i].Image = image
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("container '%s' not found in deployment '%s'", container, name)
+	}
+
+	// Update change cause annotation
+	if deployment.Annotations == nil {
+		deployment.Annotations = make(map[string]string)
+	}
+	deployment.Annotations["deployment.kubernetes.io/change-cause"] = fmt.Sprintf("Updated image for container '%s' to '%s'", container, image)
+
+	// Update deployment
+	result, err := c.clientset.AppsV1().Deployments(namespace).Update(ctx, deployment, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update deployment image: %v", err)
+	}
+
+	return result, nil
+}
+
+// SetDeploymentEnv updates environment variables in a deployment
+func (c *Client) SetDeploymentEnv(ctx context.Context, name, namespace, container string, envVars map[string]string) (*appsv1.Deployment, error) {
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	// Get current deployment
+	deployment, err := c.clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deployment '%s': %v", name, err)
 	}
 
 	// Find and update the container environment variables
@@ -1990,6 +3436,24 @@ func (c *Client) PatchDeployment(ctx context.Context, name, namespace string, pa
 		namespace = "default"
 	}
 
+	current, err := c.clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deployment '%s': %v", name, err)
+	}
+
+	// Dry-run first so a patch that changes replicas is held to the same limit as a scale
+	preview, err := c.clientset.AppsV1().Deployments(namespace).Patch(ctx, name, patchType, patchData, metav1.PatchOptions{
+		DryRun: []string{metav1.DryRunAll},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to patch deployment '%s': %v", name, err)
+	}
+	if preview.Spec.Replicas != nil && (current.Spec.Replicas == nil || *preview.Spec.Replicas != *current.Spec.Replicas) {
+		if err := c.checkReplicaLimit("deployment", name, *preview.Spec.Replicas); err != nil {
+			return nil, err
+		}
+	}
+
 	result, err := c.clientset.AppsV1().Deployments(namespace).Patch(ctx, name, patchType, patchData, metav1.PatchOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to patch deployment '%s': %v", name, err)
@@ -2073,6 +3537,79 @@ func (c *Client) SetDeploymentResources(ctx context.Context, name, namespace, co
 	return result, nil
 }
 
+// SetRolloutTiming patches progressDeadlineSeconds and/or minReadySeconds of a deployment (nil leaves a field unchanged)
+func (c *Client) SetRolloutTiming(ctx context.Context, name, namespace string, progressDeadlineSeconds, minReadySeconds *int32) (map[string]interface{}, error) {
+	if namespace == "" {
+		namespace = "default"
+	}
+	if progressDeadlineSeconds == nil && minReadySeconds == nil {
+		return nil, fmt.Errorf("at least one of progressDeadlineSeconds or minReadySeconds must be provided")
+	}
+
+	deployment, err := c.clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deployment '%s': %v", name, err)
+	}
+
+	previousMinReady := deployment.Spec.MinReadySeconds
+	previousDeadline := int32(600)
+	if deployment.Spec.ProgressDeadlineSeconds != nil {
+		previousDeadline = *deployment.Spec.ProgressDeadlineSeconds
+	}
+
+	// Validate the combination that will be in effect after the patch
+	newMinReady := previousMinReady
+	if minReadySeconds != nil {
+		newMinReady = *minReadySeconds
+	}
+	newDeadline := previousDeadline
+	if progressDeadlineSeconds != nil {
+		newDeadline = *progressDeadlineSeconds
+	}
+	if newMinReady < 0 {
+		return nil, fmt.Errorf("minReadySeconds cannot be negative")
+	}
+	if newDeadline <= newMinReady {
+		return nil, fmt.Errorf("progressDeadlineSeconds (%d) must be greater than minReadySeconds (%d)", newDeadline, newMinReady)
+	}
+
+	spec := map[string]interface{}{}
+	if progressDeadlineSeconds != nil {
+		spec["progressDeadlineSeconds"] = *progressDeadlineSeconds
+	}
+	if minReadySeconds != nil {
+		spec["minReadySeconds"] = *minReadySeconds
+	}
+	patchData, err := json.Marshal(map[string]interface{}{"spec": spec})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build patch: %v", err)
+	}
+
+	updated, err := c.clientset.AppsV1().Deployments(namespace).Patch(ctx, name, types.MergePatchType, patchData, metav1.PatchOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to set rollout timing for deployment '%s': %v", name, err)
+	}
+
+	currentDeadline := int32(600)
+	if updated.Spec.ProgressDeadlineSeconds != nil {
+		currentDeadline = *updated.Spec.ProgressDeadlineSeconds
+	}
+
+	return map[string]interface{}{
+		"deployment": name,
+		"namespace":  namespace,
+		"previous": map[string]interface{}{
+			"progressDeadlineSeconds": previousDeadline,
+			"minReadySeconds":         previousMinReady,
+		},
+		"current": map[string]interface{}{
+			"progressDeadlineSeconds": currentDeadline,
+			"minReadySeconds":         updated.Spec.MinReadySeconds,
+		},
+		"generation": updated.Generation,
+	}, nil
+}
+
 // GetDeploymentMetrics gets CPU and memory metrics for a deployment
 func (c *Client) GetDeploymentMetrics(ctx context.Context, name, namespace string) (map[string]interface{}, error) {
 	if namespace == "" {
@@ -2212,11 +3749,19 @@ func (c *Client) ListAllDeployments(ctx context.Context, labelSelector string, i
 	return result, nil
 }
 
-// ScaleAllDeployments scales all deployments in a namespace
-func (c *Client) ScaleAllDeployments(ctx context.Context, namespace string, replicas int32, labelSelector string, dryRun bool) (map[string]interface{}, error) {
+// ScaleAllDeployments scales all deployments in a namespace, updating up to `concurrency` deployments at once
+func (c *Client) ScaleAllDeployments(ctx context.Context, namespace string, replicas int32, labelSelector string, dryRun bool, concurrency int) (map[string]interface{}, error) {
 	if namespace == "" {
 		return nil, fmt.Errorf("namespace is required")
 	}
+	if concurrency <= 0 {
+		concurrency = 5
+	}
+
+	// Reject the whole batch up front rather than scaling some deployments and not others
+	if err := c.checkReplicaLimit("deployments in namespace", namespace, replicas); err != nil {
+		return nil, err
+	}
 
 	deployments, err := c.clientset.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{
 		LabelSelector: labelSelector,
@@ -2230,42 +3775,41 @@ func (c *Client) ScaleAllDeployments(ctx context.Context, namespace string, repl
 		"targetReplicas": replicas,
 		"deployments":    []map[string]interface{}{},
 		"dryRun":         dryRun,
+		"concurrency":    concurrency,
 		"totalProcessed": len(deployments.Items),
 		"successful":     0,
 		"failed":         0,
 	}
 
-	var deploymentResults []map[string]interface{}
-	successful := 0
-	failed := 0
+	// Each worker writes only its own index, so results keep the list order without locking
+	deploymentResults := make([]map[string]interface{}, len(deployments.Items))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
 
-	for _, deployment := range deployments.Items {
-		deploymentResult := map[string]interface{}{
-			"name":            deployment.Name,
-			"currentReplicas": *deployment.Spec.Replicas,
-			"targetReplicas":  replicas,
-			"status":          "",
-			"error":           "",
-		}
-
-		if !dryRun {
-			// Update the deployment
-			deployment.Spec.Replicas = &replicas
-			_, err := c.clientset.AppsV1().Deployments(namespace).Update(ctx, &deployment, metav1.UpdateOptions{})
-			if err != nil {
-				deploymentResult["status"] = "failed"
-				deploymentResult["error"] = err.Error()
-				failed++
-			} else {
-				deploymentResult["status"] = "scaled"
-				successful++
+	for w := 0; w < concurrency && w < len(deployments.Items); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				deploymentResults[i] = c.scaleDeploymentItem(ctx, namespace, deployments.Items[i], replicas, dryRun)
 			}
+		}()
+	}
+
+	for i := range deployments.Items {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	successful := 0
+	failed := 0
+	for _, deploymentResult := range deploymentResults {
+		if deploymentResult["status"] == "failed" {
+			failed++
 		} else {
-			deploymentResult["status"] = "dry-run"
 			successful++
 		}
-
-		deploymentResults = append(deploymentResults, deploymentResult)
 	}
 
 	result["deployments"] = deploymentResults
@@ -2275,42 +3819,256 @@ func (c *Client) ScaleAllDeployments(ctx context.Context, namespace string, repl
 	return result, nil
 }
 
-// ========== ADDITIONAL CLUSTER OVERVIEW OPERATIONS ==========
+// scaleDeploymentItem scales one deployment from a list, working on its own copy of the object
+func (c *Client) scaleDeploymentItem(ctx context.Context, namespace string, item appsv1.Deployment, replicas int32, dryRun bool) map[string]interface{} {
+	deployment := item.DeepCopy()
 
-// GetNamespaceResourceUsage gets resource usage summary for a namespace
-func (c *Client) GetNamespaceResourceUsage(ctx context.Context, namespace string, includeMetrics bool) (map[string]interface{}, error) {
-	if namespace == "" {
-		return nil, fmt.Errorf("namespace is required")
+	currentReplicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		currentReplicas = *deployment.Spec.Replicas
 	}
 
-	// Get namespace info
-	ns, err := c.clientset.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
-	if err != nil {
-		return nil, fmt.Errorf("failed to get namespace '%s': %v", namespace, err)
+	deploymentResult := map[string]interface{}{
+		"name":            deployment.Name,
+		"currentReplicas": currentReplicas,
+		"targetReplicas":  replicas,
+		"status":          "",
+		"error":           "",
 	}
 
-	result := map[string]interface{}{
-		"namespace":         namespace,
-		"creationTimestamp": ns.CreationTimestamp.Time.Format(time.RFC3339),
-		"status":            ns.Status.Phase,
-		"labels":            ns.Labels,
-		"annotations":       ns.Annotations,
-		"resourceCounts":    map[string]int{},
+	if dryRun {
+		deploymentResult["status"] = "dry-run"
+		return deploymentResult
 	}
 
-	resourceCounts := make(map[string]interface{})
+	// Update the deployment
+	targetReplicas := replicas
+	deployment.Spec.Replicas = &targetReplicas
+	_, err := c.clientset.AppsV1().Deployments(namespace).Update(ctx, deployment, metav1.UpdateOptions{})
+	if err != nil {
+		deploymentResult["status"] = "failed"
+		deploymentResult["error"] = err.Error()
+	} else {
+		deploymentResult["status"] = "scaled"
+	}
 
-	// Count pods
-	pods, err := c.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
-	if err == nil {
-		resourceCounts["pods"] = len(pods.Items)
+	return deploymentResult
+}
 
-		// Count pod phases
-		podPhases := make(map[string]int)
-		for _, pod := range pods.Items {
-			podPhases[string(pod.Status.Phase)]++
-		}
-		resourceCounts["podPhases"] = podPhases
+// ReconcileReplicas finds deployments whose replicas do not match spec and explains what blocks them.
+// Only deployments past their progress deadline are reported unless includeProgressing is set.
+func (c *Client) ReconcileReplicas(ctx context.Context, namespace string, includeProgressing bool) (map[string]interface{}, error) {
+	deployments, err := c.clientset.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %v", err)
+	}
+
+	scope := namespace
+	if scope == "" {
+		scope = "all namespaces"
+	}
+
+	drifted := []map[string]interface{}{}
+	stuckCount := 0
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		desired := int32(1)
+		if deployment.Spec.Replicas != nil {
+			desired = *deployment.Spec.Replicas
+		}
+
+		status := deployment.Status
+		if status.Replicas == desired && status.AvailableReplicas == desired && status.UpdatedReplicas == desired {
+			continue
+		}
+		if deployment.Spec.Paused {
+			continue
+		}
+
+		stuck := false
+		var blockers []map[string]interface{}
+		for _, condition := range status.Conditions {
+			switch {
+			case condition.Type == appsv1.DeploymentProgressing && condition.Reason == "ProgressDeadlineExceeded":
+				stuck = true
+			case condition.Type == appsv1.DeploymentReplicaFailure && condition.Status == corev1.ConditionTrue:
+				blockers = append(blockers, map[string]interface{}{
+					"category": classifyReplicaFailure(condition.Message),
+					"reason":   condition.Reason,
+					"message":  condition.Message,
+				})
+			}
+		}
+		if !stuck && !includeProgressing {
+			continue
+		}
+		if stuck {
+			stuckCount++
+		}
+
+		podBlockers, err := c.deploymentPodBlockers(ctx, deployment)
+		if err != nil {
+			blockers = append(blockers, map[string]interface{}{
+				"category": "unknown",
+				"message":  err.Error(),
+			})
+		}
+		blockers = append(blockers, podBlockers...)
+		if blockers == nil {
+			blockers = []map[string]interface{}{}
+		}
+
+		drifted = append(drifted, map[string]interface{}{
+			"name":                    deployment.Name,
+			"namespace":               deployment.Namespace,
+			"desiredReplicas":         desired,
+			"currentReplicas":         status.Replicas,
+			"updatedReplicas":         status.UpdatedReplicas,
+			"readyReplicas":           status.ReadyReplicas,
+			"availableReplicas":       status.AvailableReplicas,
+			"stuck":                   stuck,
+			"progressDeadlineSeconds": deployment.Spec.ProgressDeadlineSeconds,
+			"blockers":                blockers,
+		})
+	}
+
+	sort.Slice(drifted, func(i, j int) bool {
+		if drifted[i]["namespace"] != drifted[j]["namespace"] {
+			return drifted[i]["namespace"].(string) < drifted[j]["namespace"].(string)
+		}
+		return drifted[i]["name"].(string) < drifted[j]["name"].(string)
+	})
+
+	return map[string]interface{}{
+		"scope":              scope,
+		"totalDeployments":   len(deployments.Items),
+		"driftedCount":       len(drifted),
+		"stuckCount":         stuckCount,
+		"includeProgressing": includeProgressing,
+		"deployments":        drifted,
+	}, nil
+}
+
+// deploymentPodBlockers collects the reasons the deployment's pods are not becoming available, deduplicated by reason
+func (c *Client) deploymentPodBlockers(ctx context.Context, deployment *appsv1.Deployment) ([]map[string]interface{}, error) {
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector on deployment '%s': %v", deployment.Name, err)
+	}
+
+	pods, err := c.clientset.CoreV1().Pods(deployment.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods for deployment '%s': %v", deployment.Name, err)
+	}
+
+	seen := map[string]map[string]interface{}{}
+	var blockers []map[string]interface{}
+	record := func(category, reason, message, podName string) {
+		key := category + "/" + reason
+		if existing, exists := seen[key]; exists {
+			existing["podCount"] = existing["podCount"].(int) + 1
+			return
+		}
+		blocker := map[string]interface{}{
+			"category":   category,
+			"reason":     reason,
+			"message":    message,
+			"examplePod": podName,
+			"podCount":   1,
+		}
+		seen[key] = blocker
+		blockers = append(blockers, blocker)
+	}
+
+	for _, pod := range pods.Items {
+		if pod.DeletionTimestamp != nil || isPodReady(&pod) {
+			continue
+		}
+
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionFalse {
+				record("scheduling", condition.Reason, condition.Message, pod.Name)
+			}
+		}
+
+		statuses := append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...)
+		statuses = append(statuses, pod.Status.ContainerStatuses...)
+		for _, status := range statuses {
+			if status.State.Waiting == nil || status.State.Waiting.Reason == "" || status.State.Waiting.Reason == "PodInitializing" || status.State.Waiting.Reason == "ContainerCreating" {
+				continue
+			}
+
+			category := "container"
+			switch status.State.Waiting.Reason {
+			case "ImagePullBackOff", "ErrImagePull", "InvalidImageName", "ErrImageNeverPull":
+				category = "image-pull"
+			case "CrashLoopBackOff":
+				category = "crash-loop"
+			case "CreateContainerConfigError", "CreateContainerError":
+				category = "config"
+			}
+			record(category, status.State.Waiting.Reason, status.State.Waiting.Message, pod.Name)
+		}
+	}
+
+	return blockers, nil
+}
+
+// classifyReplicaFailure maps a ReplicaFailure condition message to a blocker category
+func classifyReplicaFailure(message string) string {
+	lower := strings.ToLower(message)
+	switch {
+	case strings.Contains(lower, "exceeded quota"), strings.Contains(lower, "resourcequota"):
+		return "quota"
+	case strings.Contains(lower, "limitrange"), strings.Contains(lower, "maximum"), strings.Contains(lower, "minimum"):
+		return "limit-range"
+	case strings.Contains(lower, "admission webhook"), strings.Contains(lower, "denied the request"):
+		return "admission"
+	case strings.Contains(lower, "forbidden"):
+		return "forbidden"
+	default:
+		return "replica-failure"
+	}
+}
+
+// ========== ADDITIONAL CLUSTER OVERVIEW OPERATIONS ==========
+
+// GetNamespaceResourceUsage gets resource usage summary for a namespace
+func (c *Client) GetNamespaceResourceUsage(ctx context.Context, namespace string, includeMetrics bool) (map[string]interface{}, error) {
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+
+	// Get namespace info
+	ns, err := c.clientset.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace '%s': %v", namespace, err)
+	}
+
+	result := map[string]interface{}{
+		"namespace":         namespace,
+		"creationTimestamp": ns.CreationTimestamp.Time.Format(time.RFC3339),
+		"status":            ns.Status.Phase,
+		"labels":            ns.Labels,
+		"annotations":       ns.Annotations,
+		"resourceCounts":    map[string]int{},
+	}
+
+	resourceCounts := make(map[string]interface{})
+
+	// Count pods
+	pods, err := c.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err == nil {
+		resourceCounts["pods"] = len(pods.Items)
+
+		// Count pod phases
+		podPhases := make(map[string]int)
+		for _, pod := range pods.Items {
+			podPhases[string(pod.Status.Phase)]++
+		}
+		resourceCounts["podPhases"] = podPhases
 	}
 
 	// Count deployments
@@ -2341,8 +4099,61 @@ func (c *Client) GetNamespaceResourceUsage(ctx context.Context, namespace string
 	return result, nil
 }
 
-// GetClusterOverview gets cluster-wide overview
-func (c *Client) GetClusterOverview(ctx context.Context, includeMetrics bool) (map[string]interface{}, error) {
+// SetOverviewCacheTTL sets how long GetClusterOverview results are reused (0 disables caching)
+func (c *Client) SetOverviewCacheTTL(ttl time.Duration) {
+	c.overviewMu.Lock()
+	defer c.overviewMu.Unlock()
+
+	c.overviewTTL = ttl
+	c.overviewCache = nil
+	c.overviewCachedAt = nil
+}
+
+// GetClusterOverview gets cluster-wide overview, served from the in-memory cache unless refresh is set or the entry expired
+func (c *Client) GetClusterOverview(ctx context.Context, includeMetrics, refresh bool) (map[string]interface{}, error) {
+	c.overviewMu.Lock()
+	defer c.overviewMu.Unlock()
+
+	if c.overviewTTL > 0 && !refresh {
+		if cached, exists := c.overviewCache[includeMetrics]; exists {
+			cachedAt := c.overviewCachedAt[includeMetrics]
+			if time.Since(cachedAt) < c.overviewTTL {
+				return withOverviewCacheInfo(cached, cachedAt, true), nil
+			}
+		}
+	}
+
+	overview, err := c.buildClusterOverview(ctx, includeMetrics)
+	if err != nil {
+		return nil, err
+	}
+
+	cachedAt := time.Now()
+	if c.overviewTTL > 0 {
+		if c.overviewCache == nil {
+			c.overviewCache = make(map[bool]map[string]interface{})
+			c.overviewCachedAt = make(map[bool]time.Time)
+		}
+		c.overviewCache[includeMetrics] = overview
+		c.overviewCachedAt[includeMetrics] = cachedAt
+	}
+
+	return withOverviewCacheInfo(overview, cachedAt, false), nil
+}
+
+// withOverviewCacheInfo returns a shallow copy of the overview annotated with cache metadata
+func withOverviewCacheInfo(overview map[string]interface{}, cachedAt time.Time, fromCache bool) map[string]interface{} {
+	result := make(map[string]interface{}, len(overview)+2)
+	for k, v := range overview {
+		result[k] = v
+	}
+	result["cachedAt"] = cachedAt.Format(time.RFC3339)
+	result["fromCache"] = fromCache
+	return result
+}
+
+// buildClusterOverview collects the cluster-wide overview from the API server
+func (c *Client) buildClusterOverview(ctx context.Context, includeMetrics bool) (map[string]interface{}, error) {
 	result := map[string]interface{}{
 		"cluster": map[string]interface{}{
 			"nodes":      map[string]interface{}{},
@@ -2443,6 +4254,121 @@ func (c *Client) GetClusterOverview(ctx context.Context, includeMetrics bool) (m
 	return result, nil
 }
 
+// controlPlaneEndpoints are the verbose health endpoints queried by GetControlPlaneHealth
+var controlPlaneEndpoints = []string{"/readyz", "/livez"}
+
+// GetControlPlaneHealth reports the individual checks behind the API server's /readyz and /livez endpoints
+func (c *Client) GetControlPlaneHealth(ctx context.Context) (map[string]interface{}, error) {
+	endpoints := map[string]interface{}{}
+	var failedChecks []string
+	reachable := 0
+
+	for _, endpoint := range controlPlaneEndpoints {
+		result := c.queryHealthEndpoint(ctx, endpoint)
+		endpoints[strings.TrimPrefix(endpoint, "/")] = result
+
+		if result["status"] == "ok" || result["status"] == "failed" {
+			reachable++
+		}
+		if result["status"] != "failed" {
+			continue
+		}
+		before := len(failedChecks)
+		checks, _ := result["checks"].([]map[string]interface{})
+		for _, check := range checks {
+			if check["status"] == "failed" {
+				failedChecks = append(failedChecks, fmt.Sprintf("%s: %s", strings.TrimPrefix(endpoint, "/"), check["name"]))
+			}
+		}
+		if len(failedChecks) == before {
+			failedChecks = append(failedChecks, strings.TrimPrefix(endpoint, "/"))
+		}
+	}
+
+	overall := "healthy"
+	switch {
+	case reachable == 0:
+		overall = "unknown"
+	case len(failedChecks) > 0:
+		overall = "degraded"
+	}
+
+	health := map[string]interface{}{
+		"status":       overall,
+		"endpoints":    endpoints,
+		"failedChecks": failedChecks,
+		"checkedAt":    time.Now().Format(time.RFC3339),
+	}
+	if failedChecks == nil {
+		health["failedChecks"] = []string{}
+	}
+	if reachable < len(controlPlaneEndpoints) {
+		health["note"] = "Some health endpoints could not be read. Managed clusters (EKS, GKE, AKS) often restrict or hide control-plane checks; the results above cover what the API server exposes"
+	}
+
+	return health, nil
+}
+
+// queryHealthEndpoint reads one verbose health endpoint and parses its '[+]name ok' / '[-]name failed: reason' lines
+func (c *Client) queryHealthEndpoint(ctx context.Context, endpoint string) map[string]interface{} {
+	result := map[string]interface{}{
+		"endpoint": endpoint + "?verbose",
+	}
+
+	// A failing check makes the API server answer 500, but the body still lists every check
+	data, err := c.clientset.Discovery().RESTClient().Get().AbsPath(endpoint).Param("verbose", "").DoRaw(ctx)
+	body := string(data)
+	if err != nil && !strings.Contains(body, "[+]") && !strings.Contains(body, "[-]") {
+		switch {
+		case apierrors.IsForbidden(err), apierrors.IsUnauthorized(err):
+			result["status"] = "restricted"
+		case apierrors.IsNotFound(err):
+			result["status"] = "unavailable"
+		default:
+			result["status"] = "error"
+		}
+		result["error"] = err.Error()
+		return result
+	}
+
+	checks := []map[string]interface{}{}
+	passed := 0
+	failed := 0
+	for _, line := range strings.Split(body, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "[+]"):
+			checks = append(checks, map[string]interface{}{
+				"name":   strings.TrimSuffix(strings.TrimPrefix(line, "[+]"), " ok"),
+				"status": "ok",
+			})
+			passed++
+		case strings.HasPrefix(line, "[-]"):
+			name := strings.TrimPrefix(line, "[-]")
+			message := ""
+			if idx := strings.Index(name, " failed"); idx >= 0 {
+				message = strings.TrimPrefix(strings.TrimSpace(name[idx+len(" failed"):]), ": ")
+				name = name[:idx]
+			}
+			checks = append(checks, map[string]interface{}{
+				"name":    name,
+				"status":  "failed",
+				"message": message,
+			})
+			failed++
+		}
+	}
+
+	result["status"] = "ok"
+	if err != nil || failed > 0 {
+		result["status"] = "failed"
+	}
+	result["checks"] = checks
+	result["passed"] = passed
+	result["failed"] = failed
+	return result
+}
+
 // ========== ADDITIONAL POD OPERATIONS ==========
 
 // GetPodResourceUsage gets resource usage for a specific pod
@@ -2499,8 +4425,8 @@ func (c *Client) GetPodResourceUsage(ctx context.Context, name, namespace string
 	return result, nil
 }
 
-// GetPodsHealthStatus gets health status overview of pods in a namespace
-func (c *Client) GetPodsHealthStatus(ctx context.Context, namespace, labelSelector string) (map[string]interface{}, error) {
+// GetPodsHealthStatus gets health status overview of pods in a namespace, optionally with current usage sorted by sortBy ("cpu" or "memory")
+func (c *Client) GetPodsHealthStatus(ctx context.Context, namespace, labelSelector string, includeUsage bool, sortBy string) (map[string]interface{}, error) {
 	if namespace == "" {
 		namespace = "default"
 	}
@@ -2567,95 +4493,506 @@ func (c *Client) GetPodsHealthStatus(ctx context.Context, namespace, labelSelect
 
 	result["summary"] = summary
 	result["pods"] = podList
+
+	if includeUsage {
+		c.addPodsUsage(ctx, namespace, podList, sortBy, result)
+	}
+
 	return result, nil
 }
 
-// ========== SERVICE OPERATIONS ==========
-
-// ListServices returns a list of services in the specified namespace
-func (c *Client) ListServices(ctx context.Context, namespace string) ([]map[string]interface{}, error) {
-	if namespace == "" {
-		namespace = "default"
+// addPodsUsage attaches metrics-server usage to the health entries and sorts them by usage descending
+func (c *Client) addPodsUsage(ctx context.Context, namespace string, podList []map[string]interface{}, sortBy string, result map[string]interface{}) {
+	metrics, err := c.listPodMetrics(ctx, namespace)
+	if err != nil {
+		// Usage is best effort; the health overview is still useful without it
+		result["usageError"] = err.Error()
+		return
 	}
 
-	services, err := c.clientset.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{})
-	if err != nil {
-		return nil, fmt.Errorf("failed to list services in namespace '%s': %v", namespace, err)
+	byName := map[string]podMetrics{}
+	for _, pm := range metrics {
+		byName[pm.Metadata.Name] = pm
 	}
 
-	var result []map[string]interface{}
-	for _, service := range services.Items {
-		serviceInfo := map[string]interface{}{
-			"name":              service.Name,
-			"namespace":         service.Namespace,
-			"type":              string(service.Spec.Type),
-			"clusterIP":         service.Spec.ClusterIP,
-			"externalIPs":       service.Spec.ExternalIPs,
-			"ports":             service.Spec.Ports,
-			"selector":          service.Spec.Selector,
-			"creationTimestamp": service.CreationTimestamp.Time.Format(time.RFC3339),
-			"labels":            service.Labels,
-			"annotations":       service.Annotations,
+	var totalCPU, totalMemory int64
+	withMetrics := 0
+	for _, podInfo := range podList {
+		pm, exists := byName[podInfo["name"].(string)]
+		if !exists {
+			podInfo["usage"] = nil
+			continue
 		}
 
-		// Add external access information
-		switch service.Spec.Type {
-case corev1.ServiceTypeNodePort:
-			serviceInfo["nodePort"] = service.Spec.Ports
-		case corev1.ServiceTypeLoadBalancer:
-			serviceInfo["loadBalancerIP"] = service.Spec.LoadBalancerIP
-			serviceInfo["loadBalancerIngress"] = service.Status.LoadBalancer.Ingress
+		withMetrics++
+		cpu, memory := podUsage(pm)
+		totalCPU += cpu
+		totalMemory += memory
+		podInfo["usage"] = map[string]interface{}{
+			"cpuMillicores": cpu,
+			"memoryBytes":   memory,
+			"cpu":           resource.NewMilliQuantity(cpu, resource.DecimalSI).String(),
+			"memory":        resource.NewQuantity(memory, resource.BinarySI).String(),
 		}
 
-		result = append(result, serviceInfo)
+		containerStatuses, _ := podInfo["containerStatuses"].([]map[string]interface{})
+		for _, container := range pm.Containers {
+			for _, status := range containerStatuses {
+				if status["name"] != container.Name {
+					continue
+				}
+				if q, ok := container.Usage[corev1.ResourceCPU]; ok {
+					status["cpuMillicores"] = q.MilliValue()
+				}
+				if q, ok := container.Usage[corev1.ResourceMemory]; ok {
+					status["memoryBytes"] = q.Value()
+				}
+			}
+		}
 	}
 
-	return result, nil
+	usageValue := func(podInfo map[string]interface{}) int64 {
+		usage, ok := podInfo["usage"].(map[string]interface{})
+		if !ok {
+			return -1
+		}
+		if sortBy == "memory" {
+			return usage["memoryBytes"].(int64)
+		}
+		return usage["cpuMillicores"].(int64)
+	}
+	sort.SliceStable(podList, func(i, j int) bool {
+		return usageValue(podList[i]) > usageValue(podList[j])
+	})
+
+	if sortBy != "memory" {
+		sortBy = "cpu"
+	}
+	result["sortedBy"] = sortBy
+	result["usage"] = map[string]interface{}{
+		"totalCpuMillicores": totalCPU,
+		"totalMemoryBytes":   totalMemory,
+		"totalCpu":           resource.NewMilliQuantity(totalCPU, resource.DecimalSI).String(),
+		"totalMemory":        resource.NewQuantity(totalMemory, resource.BinarySI).String(),
+		"podsWithMetrics":    withMetrics,
+	}
 }
 
-// ListServicesWithSelector returns services filtered by label selector
-func (c *Client) ListServicesWithSelector(ctx context.Context, namespace, labelSelector string) ([]map[string]interface{}, error) {
+// GetResourceRatios reports limit/request ratios per container in a namespace and ranks containers by resource-hygiene problems
+func (c *Client) GetResourceRatios(ctx context.Context, namespace, labelSelector string, maxRatio float64, limit int) (map[string]interface{}, error) {
 	if namespace == "" {
 		namespace = "default"
 	}
+	if maxRatio <= 0 {
+		maxRatio = 4
+	}
 
-	services, err := c.clientset.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{
+	pods, err := c.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
 		LabelSelector: labelSelector,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to list services with selector '%s' in namespace '%s': %v", labelSelector, namespace, err)
+		return nil, fmt.Errorf("failed to list pods: %v", err)
 	}
 
-	var result []map[string]interface{}
-	for _, service := range services.Items {
-		serviceInfo := map[string]interface{}{
-			"name":              service.Name,
-			"namespace":         service.Namespace,
-			"type":              string(service.Spec.Type),
-			"clusterIP":         service.Spec.ClusterIP,
-			"ports":             service.Spec.Ports,
-			"selector":          service.Spec.Selector,
-			"creationTimestamp": service.CreationTimestamp.Time.Format(time.RFC3339),
-			"labels":            service.Labels,
-		}
-		result = append(result, serviceInfo)
+	type ratioEntry struct {
+		info  map[string]interface{}
+		score float64
 	}
 
-	return result, nil
-}
+	// Replicas of the same workload share a spec, so report each owner/container pair once
+	entries := map[string]*ratioEntry{}
+	flagCounts := map[string]int{}
+	totalContainers := 0
 
-// GetService returns detailed information about a specific service
-func (c *Client) GetService(ctx context.Context, name, namespace string) (map[string]interface{}, error) {
-	if namespace == "" {
-		namespace = "default"
+	for _, pod := range pods.Items {
+		owner := "Pod/" + pod.Name
+		if ref := metav1.GetControllerOf(&pod); ref != nil {
+			owner = ref.Kind + "/" + ref.Name
+		}
+
+		for _, container := range pod.Spec.Containers {
+			totalContainers++
+
+			key := owner + "/" + container.Name
+			if entry, exists := entries[key]; exists {
+				entry.info["podCount"] = entry.info["podCount"].(int) + 1
+				continue
+			}
+
+			var flags []string
+			var score float64
+			info := map[string]interface{}{
+				"owner":     owner,
+				"pod":       pod.Name,
+				"container": container.Name,
+				"podCount":  1,
+			}
+
+			for _, res := range []struct {
+				name     corev1.ResourceName
+				label    string
+				quantity func(resource.Quantity) int64
+			}{
+				{corev1.ResourceCPU, "cpu", func(q resource.Quantity) int64 { return q.MilliValue() }},
+				{corev1.ResourceMemory, "memory", func(q resource.Quantity) int64 { return q.Value() }},
+			} {
+				resourceInfo := map[string]interface{}{}
+				request, hasRequest := container.Resources.Requests[res.name]
+				limitQty, hasLimit := container.Resources.Limits[res.name]
+
+				if hasRequest {
+					resourceInfo["request"] = request.String()
+				} else {
+					flags = append(flags, "no-"+res.label+"-request")
+					score += 3
+				}
+				if hasLimit {
+					resourceInfo["limit"] = limitQty.String()
+				} else {
+					flags = append(flags, "no-"+res.label+"-limit")
+					score += 2
+				}
+
+				if hasRequest && hasLimit && res.quantity(request) > 0 {
+					ratio := float64(res.quantity(limitQty)) / float64(res.quantity(request))
+					resourceInfo["ratio"] = float64(int(ratio*100)) / 100
+					if ratio > maxRatio {
+						flags = append(flags, "high-"+res.label+"-ratio")
+						score += ratio / maxRatio
+					}
+				}
+
+				info[res.label] = resourceInfo
+			}
+
+			for _, flag := range flags {
+				flagCounts[flag]++
+			}
+			if flags == nil {
+				flags = []string{}
+			}
+			info["flags"] = flags
+			info["score"] = float64(int(score*100)) / 100
+
+			entries[key] = &ratioEntry{info: info, score: score}
+		}
 	}
 
-	service, err := c.clientset.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
-	if err != nil {
-		return nil, fmt.Errorf("failed to get service '%s' in namespace '%s': %v", name, namespace, err)
+	sorted := make([]*ratioEntry, 0, len(entries))
+	for _, entry := range entries {
+		sorted = append(sorted, entry)
 	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].score != sorted[j].score {
+			return sorted[i].score > sorted[j].score
+		}
+		if sorted[i].info["owner"] != sorted[j].info["owner"] {
+			return sorted[i].info["owner"].(string) < sorted[j].info["owner"].(string)
+		}
+		return sorted[i].info["container"].(string) < sorted[j].info["container"].(string)
+	})
 
-	result := map[string]interface{}{
+	offenders := []map[string]interface{}{}
+	compliant := 0
+	for _, entry := range sorted {
+		if entry.score == 0 {
+			compliant++
+			continue
+		}
+		if limit > 0 && len(offenders) >= limit {
+			continue
+		}
+		offenders = append(offenders, entry.info)
+	}
+
+	return map[string]interface{}{
+		"namespace":        namespace,
+		"maxRatio":         maxRatio,
+		"totalPods":        len(pods.Items),
+		"totalContainers":  totalContainers,
+		"uniqueContainers": len(entries),
+		"compliantCount":   compliant,
+		"offenderCount":    len(entries) - compliant,
+		"flagCounts":       flagCounts,
+		"worstOffenders":   offenders,
+	}, nil
+}
+
+// recentRestartWindow is how long after a restart a container still counts as recently restarted
+const recentRestartWindow = 15 * time.Minute
+
+// GetContainerRestarts reports per-container restart details for a pod or every pod of a deployment.
+// When sampleSeconds is positive, restart counts are read twice that far apart to detect containers restarting right now.
+func (c *Client) GetContainerRestarts(ctx context.Context, namespace, podName, deploymentName string, sampleSeconds int) (map[string]interface{}, error) {
+	if namespace == "" {
+		namespace = "default"
+	}
+	if podName == "" && deploymentName == "" {
+		return nil, fmt.Errorf("either a pod name or a deployment name is required")
+	}
+
+	listPods := func() ([]corev1.Pod, error) {
+		if podName != "" {
+			pod, err := c.clientset.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get pod '%s' in namespace '%s': %v", podName, namespace, err)
+			}
+			return []corev1.Pod{*pod}, nil
+		}
+
+		deployment, err := c.clientset.AppsV1().Deployments(namespace).Get(ctx, deploymentName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get deployment '%s': %v", deploymentName, err)
+		}
+		selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector on deployment '%s': %v", deploymentName, err)
+		}
+		pods, err := c.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: selector.String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pods for deployment '%s': %v", deploymentName, err)
+		}
+		return pods.Items, nil
+	}
+
+	pods, err := listPods()
+	if err != nil {
+		return nil, err
+	}
+
+	initialCounts := map[string]int32{}
+	for _, pod := range pods {
+		for _, status := range pod.Status.ContainerStatuses {
+			initialCounts[pod.Name+"/"+status.Name] = status.RestartCount
+		}
+	}
+
+	if sampleSeconds > 0 {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Duration(sampleSeconds) * time.Second):
+		}
+		if pods, err = listPods(); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	summary := map[string]int{
+		"restarting-now":     0,
+		"recently-restarted": 0,
+		"stable":             0,
+		"never-restarted":    0,
+	}
+
+	containers := []map[string]interface{}{}
+	podCount := 0
+	for _, pod := range pods {
+		// Completed pods (e.g. finished Jobs) are not restarting
+		if pod.Status.Phase == corev1.PodSucceeded {
+			continue
+		}
+		podCount++
+
+		for _, status := range pod.Status.ContainerStatuses {
+			info := map[string]interface{}{
+				"pod":          pod.Name,
+				"container":    status.Name,
+				"restartCount": status.RestartCount,
+				"ready":        status.Ready,
+			}
+
+			restartsDuringSample := int32(0)
+			if sampleSeconds > 0 {
+				if initial, seen := initialCounts[pod.Name+"/"+status.Name]; seen {
+					restartsDuringSample = status.RestartCount - initial
+				}
+				info["restartsDuringSample"] = restartsDuringSample
+			}
+
+			waitingReason := ""
+			switch {
+			case status.State.Running != nil:
+				info["state"] = "running"
+				info["runningSince"] = status.State.Running.StartedAt.Time
+			case status.State.Waiting != nil:
+				waitingReason = status.State.Waiting.Reason
+				info["state"] = "waiting"
+				info["stateReason"] = waitingReason
+			case status.State.Terminated != nil:
+				info["state"] = "terminated"
+				info["stateReason"] = status.State.Terminated.Reason
+			}
+
+			var lastRestart time.Time
+			if terminated := status.LastTerminationState.Terminated; terminated != nil {
+				info["lastTermination"] = map[string]interface{}{
+					"reason":     terminated.Reason,
+					"exitCode":   terminated.ExitCode,
+					"signal":     terminated.Signal,
+					"message":    terminated.Message,
+					"startedAt":  terminated.StartedAt.Time,
+					"finishedAt": terminated.FinishedAt.Time,
+				}
+				lastRestart = terminated.FinishedAt.Time
+				info["lastRestartAt"] = lastRestart
+				info["timeSinceLastRestart"] = now.Sub(lastRestart).Round(time.Second).String()
+			}
+
+			// A terminated container is only about to restart if its restart policy applies to how it exited
+			terminatedWillRestart := false
+			if terminated := status.State.Terminated; terminated != nil {
+				switch pod.Spec.RestartPolicy {
+				case corev1.RestartPolicyNever:
+				case corev1.RestartPolicyOnFailure:
+					terminatedWillRestart = terminated.ExitCode != 0
+				default:
+					terminatedWillRestart = true
+				}
+			}
+
+			restartStatus := "never-restarted"
+			switch {
+			case restartsDuringSample > 0 || waitingReason == "CrashLoopBackOff" || terminatedWillRestart:
+				restartStatus = "restarting-now"
+			case !lastRestart.IsZero() && now.Sub(lastRestart) < recentRestartWindow:
+				restartStatus = "recently-restarted"
+			case status.RestartCount > 0:
+				restartStatus = "stable"
+			}
+			info["restartStatus"] = restartStatus
+			summary[restartStatus]++
+
+			containers = append(containers, info)
+		}
+	}
+
+	// Most urgent first, then by restart count
+	statusRank := map[string]int{"restarting-now": 0, "recently-restarted": 1, "stable": 2, "never-restarted": 3}
+	sort.SliceStable(containers, func(i, j int) bool {
+		ri, rj := statusRank[containers[i]["restartStatus"].(string)], statusRank[containers[j]["restartStatus"].(string)]
+		if ri != rj {
+			return ri < rj
+		}
+		return containers[i]["restartCount"].(int32) > containers[j]["restartCount"].(int32)
+	})
+
+	result := map[string]interface{}{
+		"namespace":     namespace,
+		"podCount":      podCount,
+		"containers":    containers,
+		"summary":       summary,
+		"sampleSeconds": sampleSeconds,
+	}
+	if podName != "" {
+		result["pod"] = podName
+	} else {
+		result["deployment"] = deploymentName
+	}
+
+	return result, nil
+}
+
+// ========== SERVICE OPERATIONS ==========
+
+// listServiceObjects returns the services in a namespace (all namespaces when empty) matching an optional label selector
+func (c *Client) listServiceObjects(ctx context.Context, namespace, labelSelector string) ([]corev1.Service, error) {
+	services, err := c.clientset.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labelSelector,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return services.Items, nil
+}
+
+// ListServices returns a list of services in the specified namespace
+func (c *Client) ListServices(ctx context.Context, namespace string) ([]map[string]interface{}, error) {
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	services, err := c.listServiceObjects(ctx, namespace, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list services in namespace '%s': %v", namespace, err)
+	}
+
+	var result []map[string]interface{}
+	for _, service := range services {
+		serviceInfo := map[string]interface{}{
+			"name":              service.Name,
+			"namespace":         service.Namespace,
+			"type":              string(service.Spec.Type),
+			"clusterIP":         service.Spec.ClusterIP,
+			"externalIPs":       service.Spec.ExternalIPs,
+			"ports":             service.Spec.Ports,
+			"selector":          service.Spec.Selector,
+			"creationTimestamp": service.CreationTimestamp.Time.Format(time.RFC3339),
+			"labels":            service.Labels,
+			"annotations":       service.Annotations,
+		}
+
+		// Add external access information
+		switch service.Spec.Type {
+case corev1.ServiceTypeNodePort:
+			serviceInfo["nodePort"] = service.Spec.Ports
+		case corev1.ServiceTypeLoadBalancer:
+			serviceInfo["loadBalancerIP"] = service.Spec.LoadBalancerIP
+			serviceInfo["loadBalancerIngress"] = service.Status.LoadBalancer.Ingress
+		}
+
+		result = append(result, serviceInfo)
+	}
+
+	return result, nil
+}
+
+// ListServicesWithSelector returns services filtered by label selector
+func (c *Client) ListServicesWithSelector(ctx context.Context, namespace, labelSelector string) ([]map[string]interface{}, error) {
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	services, err := c.clientset.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labelSelector,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list services with selector '%s' in namespace '%s': %v", labelSelector, namespace, err)
+	}
+
+	var result []map[string]interface{}
+	for _, service := range services.Items {
+		serviceInfo := map[string]interface{}{
+			"name":              service.Name,
+			"namespace":         service.Namespace,
+			"type":              string(service.Spec.Type),
+			"clusterIP":         service.Spec.ClusterIP,
+			"ports":             service.Spec.Ports,
+			"selector":          service.Spec.Selector,
+			"creationTimestamp": service.CreationTimestamp.Time.Format(time.RFC3339),
+			"labels":            service.Labels,
+		}
+		result = append(result, serviceInfo)
+	}
+
+	return result, nil
+}
+
+// GetService returns detailed information about a specific service
+func (c *Client) GetService(ctx context.Context, name, namespace string) (map[string]interface{}, error) {
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	service, err := c.clientset.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service '%s' in namespace '%s': %v", name, namespace, err)
+	}
+
+	result := map[string]interface{}{
 		"name":              service.Name,
 		"namespace":         service.Namespace,
 		"uid":               service.UID,
@@ -3052,22 +5389,20 @@ func (c *Client) ListAllServices(ctx context.Context, labelSelector string, incl
 			continue
 		}
 
-		services, err := c.clientset.CoreV1().Services(ns.Name).List(ctx, metav1.ListOptions{
-			LabelSelector: labelSelector,
-		})
+		services, err := c.listServiceObjects(ctx, ns.Name, labelSelector)
 		if err != nil {
 			continue // Skip this namespace if we can't list services
 		}
 
-		if len(services.Items) > 0 {
+		if len(services) > 0 {
 			nsInfo := map[string]interface{}{
 				"namespace":    ns.Name,
-				"serviceCount": len(services.Items),
+				"serviceCount": len(services),
 				"services":     []map[string]interface{}{},
 			}
 
 			var serviceList []map[string]interface{}
-			for _, service := range services.Items {
+			for _, service := range services {
 				serviceInfo := map[string]interface{}{
 					"name":              service.Name,
 					"type":              string(service.Spec.Type),
@@ -3082,7 +5417,7 @@ func (c *Client) ListAllServices(ctx context.Context, labelSelector string, incl
 
 			nsInfo["services"] = serviceList
 			allNamespaces = append(allNamespaces, nsInfo)
-			totalServices += len(services.Items)
+			totalServices += len(services)
 		}
 	}
 
@@ -3212,51 +5547,2903 @@ func (c *Client) GetServiceTopology(ctx context.Context, name, namespace string)
 	return result, nil
 }
 
-// CreateServiceFromPods creates a service from pod selector
-func (c *Client) CreateServiceFromPods(ctx context.Context, serviceName, namespace, labelSelector string, port, targetPort int32, serviceType string) (*corev1.Service, error) {
+// TraceServicePath walks service -> selector -> pods -> readiness -> container ports -> endpoints and reports where the chain breaks
+func (c *Client) TraceServicePath(ctx context.Context, name, namespace string) (map[string]interface{}, error) {
 	if namespace == "" {
 		namespace = "default"
 	}
-	if targetPort == 0 {
-		targetPort = port
+
+	service, err := c.clientset.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service '%s' in namespace '%s': %v", name, namespace, err)
 	}
-	if serviceType == "" {
-		serviceType = "ClusterIP"
+
+	steps := []map[string]interface{}{}
+	addStep := func(step, status, detail string, extra map[string]interface{}) {
+		entry := map[string]interface{}{
+			"step":   step,
+			"status": status,
+			"detail": detail,
+		}
+		for k, v := range extra {
+			entry[k] = v
+		}
+		steps = append(steps, entry)
+	}
+	finish := func() map[string]interface{} {
+		verdict := "reachable"
+		brokenAt := ""
+		warnings := 0
+		for _, step := range steps {
+			switch step["status"] {
+			case "fail":
+				if brokenAt == "" {
+					brokenAt = step["step"].(string)
+				}
+			case "warning":
+				warnings++
+			}
+		}
+		if brokenAt != "" {
+			verdict = "broken"
+		} else if warnings > 0 {
+			verdict = "reachable-with-warnings"
+		}
+
+		result := map[string]interface{}{
+			"serviceName": name,
+			"namespace":   namespace,
+			"serviceType": string(service.Spec.Type),
+			"verdict":     verdict,
+			"steps":       steps,
+		}
+		if brokenAt != "" {
+			result["brokenAt"] = brokenAt
+		}
+		return result
 	}
 
-	// Parse label selector
-	selector, err := metav1.ParseToLabelSelector(labelSelector)
-	if err != nil {
-		return nil, fmt.Errorf("invalid label selector '%s': %v", labelSelector, err)
+	// Step 1: service
+	if service.Spec.Type == corev1.ServiceTypeExternalName {
+		addStep("service", "ok", fmt.Sprintf("ExternalName service resolving to '%s' - no pods are involved", service.Spec.ExternalName), nil)
+		return finish(), nil
 	}
+	addStep("service", "ok", fmt.Sprintf("%s service with clusterIP %s and %d port(s)", service.Spec.Type, service.Spec.ClusterIP, len(service.Spec.Ports)), nil)
 
-	// Create service
-	service := &corev1.Service{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      serviceName,
-			Namespace: namespace,
-			Labels: map[string]string{
-				"app.kubernetes.io/name":       serviceName,
-				"app.kubernetes.io/created-by": "k8s-mcp-server",
-			},
-		},
-		Spec: corev1.ServiceSpec{
-			Type:     corev1.ServiceType(serviceType),
-			Selector: selector.MatchLabels,
-			Ports: []corev1.ServicePort{
-				{
-					Port:       port,
-					TargetPort: intstr.FromInt(int(targetPort)),
-					Protocol:   corev1.ProtocolTCP,
-				},
-			},
-		},
+	// Step 2: selector
+	if len(service.Spec.Selector) == 0 {
+		addStep("selector", "warning", "Service has no selector - endpoints must be managed manually, pod checks are skipped", nil)
+		c.traceEndpointsStep(ctx, service, addStep)
+		return finish(), nil
 	}
+	selector := labels.SelectorFromSet(service.Spec.Selector)
+	addStep("selector", "ok", fmt.Sprintf("Selector %s", selector.String()), nil)
 
-	createdService, err := c.clientset.CoreV1().Services(namespace).Create(ctx, service, metav1.CreateOptions{})
+	// Step 3: matching pods
+	allPods, err := c.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create service '%s': %v", serviceName, err)
+		return nil, fmt.Errorf("failed to list pods in namespace '%s': %v", namespace, err)
 	}
 
-	return createdService, nil
+	var matching []corev1.Pod
+	var nearMisses []map[string]interface{}
+	for _, pod := range allPods.Items {
+		if selector.Matches(labels.Set(pod.Labels)) {
+			matching = append(matching, pod)
+			continue
+		}
+
+		// Pods missing exactly one selector label are the usual typo suspects
+		var mismatched []string
+		for key, value := range service.Spec.Selector {
+			if pod.Labels[key] != value {
+				mismatched = append(mismatched, fmt.Sprintf("%s=%s (pod has '%s')", key, value, pod.Labels[key]))
+			}
+		}
+		if len(mismatched) == 1 && len(nearMisses) < 5 {
+			nearMisses = append(nearMisses, map[string]interface{}{
+				"pod":        pod.Name,
+				"mismatched": mismatched[0],
+			})
+		}
+	}
+
+	if len(matching) == 0 {
+		extra := map[string]interface{}{}
+		if len(nearMisses) > 0 {
+			extra["nearMisses"] = nearMisses
+		}
+		addStep("pods", "fail", "No pods match the service selector", extra)
+		c.traceEndpointsStep(ctx, service, addStep)
+		return finish(), nil
+	}
+	addStep("pods", "ok", fmt.Sprintf("%d pod(s) match the selector", len(matching)), nil)
+
+	// Step 4: readiness
+	var readyPods []corev1.Pod
+	var notReady []map[string]interface{}
+	for _, pod := range matching {
+		if pod.DeletionTimestamp == nil && isPodReady(&pod) {
+			readyPods = append(readyPods, pod)
+			continue
+		}
+
+		reason := string(pod.Status.Phase)
+		if pod.DeletionTimestamp != nil {
+			reason = "Terminating"
+		}
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == corev1.PodReady && condition.Reason != "" {
+				reason = condition.Reason
+			}
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.State.Waiting != nil && status.State.Waiting.Reason != "" {
+				reason = status.State.Waiting.Reason
+			}
+		}
+		notReady = append(notReady, map[string]interface{}{
+			"pod":    pod.Name,
+			"reason": reason,
+		})
+	}
+
+	switch {
+	case len(readyPods) == 0:
+		addStep("readiness", "fail", fmt.Sprintf("None of the %d matching pod(s) are ready", len(matching)), map[string]interface{}{"notReadyPods": notReady})
+	case len(notReady) > 0:
+		addStep("readiness", "warning", fmt.Sprintf("%d of %d matching pod(s) are ready", len(readyPods), len(matching)), map[string]interface{}{"notReadyPods": notReady})
+	default:
+		addStep("readiness", "ok", fmt.Sprintf("All %d matching pod(s) are ready", len(matching)), nil)
+	}
+
+	// Step 5: targetPort against container ports, checked on ready pods (or all matching pods if none are ready)
+	portPods := readyPods
+	if len(portPods) == 0 {
+		portPods = matching
+	}
+	portResults := []map[string]interface{}{}
+	portStatus := "ok"
+	for _, servicePort := range service.Spec.Ports {
+		target := servicePort.TargetPort
+		if target.Type == intstr.Int && target.IntVal == 0 {
+			target = intstr.FromInt32(servicePort.Port)
+		}
+
+		resolved, undeclared, missing := 0, 0, []string{}
+		for _, pod := range portPods {
+			found := false
+			for _, container := range pod.Spec.Containers {
+				for _, containerPort := range container.Ports {
+					if containerPort.Protocol != "" && servicePort.Protocol != "" && containerPort.Protocol != servicePort.Protocol {
+						continue
+					}
+					if (target.Type == intstr.String && containerPort.Name == target.StrVal) ||
+						(target.Type == intstr.Int && containerPort.ContainerPort == target.IntVal) {
+						found = true
+					}
+				}
+			}
+
+			switch {
+			case found:
+				resolved++
+			case target.Type == intstr.Int:
+				// Container ports are informational: traffic still reaches an undeclared port, so this cannot be verified
+				undeclared++
+			default:
+				missing = append(missing, pod.Name)
+			}
+		}
+
+		portInfo := map[string]interface{}{
+			"port":       servicePort.Port,
+			"targetPort": target.String(),
+			"protocol":   string(servicePort.Protocol),
+			"matched":    resolved,
+		}
+		if servicePort.Name != "" {
+			portInfo["name"] = servicePort.Name
+		}
+		if undeclared > 0 {
+			portInfo["unverified"] = undeclared
+		}
+		switch {
+		case len(missing) > 0:
+			portInfo["status"] = "fail"
+			portInfo["podsWithoutPort"] = missing
+			portStatus = "fail"
+		case undeclared > 0:
+			portInfo["status"] = "warning"
+			if portStatus == "ok" {
+				portStatus = "warning"
+			}
+		default:
+			portInfo["status"] = "ok"
+		}
+		portResults = append(portResults, portInfo)
+	}
+
+	portDetail := "Every service targetPort matches a container port"
+	switch portStatus {
+	case "fail":
+		portDetail = "Some named service targetPorts do not resolve to a container port on the pods"
+	case "warning":
+		portDetail = "Some numeric targetPorts are not declared by the pods, so they could not be verified"
+	}
+	addStep("ports", portStatus, portDetail, map[string]interface{}{"ports": portResults})
+
+	// Step 6: endpoints
+	c.traceEndpointsStep(ctx, service, addStep)
+
+	return finish(), nil
+}
+
+// traceEndpointsStep adds the endpoints step to a service trace
+func (c *Client) traceEndpointsStep(ctx context.Context, service *corev1.Service, addStep func(step, status, detail string, extra map[string]interface{})) {
+	endpoints, err := c.clientset.CoreV1().Endpoints(service.Namespace).Get(ctx, service.Name, metav1.GetOptions{})
+	if err != nil {
+		addStep("endpoints", "fail", fmt.Sprintf("No endpoints object for the service: %v", err), nil)
+		return
+	}
+
+	ready, notReady := 0, 0
+	for _, subset := range endpoints.Subsets {
+		ready += len(subset.Addresses)
+		notReady += len(subset.NotReadyAddresses)
+	}
+
+	extra := map[string]interface{}{
+		"readyAddresses":    ready,
+		"notReadyAddresses": notReady,
+	}
+	switch {
+	case ready == 0:
+		addStep("endpoints", "fail", "The service has no ready endpoint addresses, so traffic has nowhere to go", extra)
+	case notReady > 0:
+		addStep("endpoints", "warning", fmt.Sprintf("%d ready and %d not-ready endpoint address(es)", ready, notReady), extra)
+	default:
+		addStep("endpoints", "ok", fmt.Sprintf("%d ready endpoint address(es)", ready), extra)
+	}
+}
+
+// CheckPortConflicts scans services for NodePort collisions, duplicate ports within a service, and services that
+// select the same pods on overlapping target ports. NodePorts are cluster-wide, so they are always checked across all namespaces.
+func (c *Client) CheckPortConflicts(ctx context.Context, namespace string) (map[string]interface{}, error) {
+	allServices, err := c.listServiceObjects(ctx, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list services: %v", err)
+	}
+
+	var services []corev1.Service
+	for _, service := range allServices {
+		if namespace == "" || service.Namespace == namespace {
+			services = append(services, service)
+		}
+	}
+
+	// NodePort collisions (same port and protocol claimed by more than one service port)
+	nodePortOwners := map[string][]string{}
+	usedNodePorts := map[int32]bool{}
+	for _, service := range allServices {
+		for _, port := range service.Spec.Ports {
+			if port.NodePort == 0 {
+				continue
+			}
+			usedNodePorts[port.NodePort] = true
+			key := fmt.Sprintf("%d/%s", port.NodePort, port.Protocol)
+			nodePortOwners[key] = append(nodePortOwners[key], service.Namespace+"/"+service.Name)
+		}
+	}
+
+	nodePortConflicts := []map[string]interface{}{}
+	for key, owners := range nodePortOwners {
+		if len(owners) < 2 {
+			continue
+		}
+		if namespace != "" && !ownersInNamespace(owners, namespace) {
+			continue
+		}
+		nodePortConflicts = append(nodePortConflicts, map[string]interface{}{
+			"nodePort": key,
+			"services": owners,
+		})
+	}
+	sort.Slice(nodePortConflicts, func(i, j int) bool {
+		return nodePortConflicts[i]["nodePort"].(string) < nodePortConflicts[j]["nodePort"].(string)
+	})
+
+	// Duplicate definitions inside a single service
+	duplicatePorts := []map[string]interface{}{}
+	for _, service := range services {
+		seenPorts := map[string]bool{}
+		seenNames := map[string]bool{}
+		seenTargets := map[string]int32{}
+		for _, port := range service.Spec.Ports {
+			portKey := fmt.Sprintf("%d/%s", port.Port, port.Protocol)
+			if seenPorts[portKey] {
+				duplicatePorts = append(duplicatePorts, portConflictEntry(service, "duplicate-port", fmt.Sprintf("Port %s is defined more than once", portKey), "error"))
+			}
+			seenPorts[portKey] = true
+
+			if port.Name != "" {
+				if seenNames[port.Name] {
+					duplicatePorts = append(duplicatePorts, portConflictEntry(service, "duplicate-name", fmt.Sprintf("Port name '%s' is used more than once", port.Name), "error"))
+				}
+				seenNames[port.Name] = true
+			}
+
+			targetKey := port.TargetPort.String() + "/" + string(port.Protocol)
+			if other, exists := seenTargets[targetKey]; exists {
+				duplicatePorts = append(duplicatePorts, portConflictEntry(service, "shared-target-port", fmt.Sprintf("Ports %d and %d both forward to targetPort %s", other, port.Port, targetKey), "warning"))
+			} else {
+				seenTargets[targetKey] = port.Port
+			}
+		}
+	}
+
+	// Services in the same namespace that select common pods on overlapping target ports
+	byNamespace := map[string][]corev1.Service{}
+	for _, service := range services {
+		// Headless services have no virtual IP, so their ports cannot compete with another service's
+		if len(service.Spec.Selector) > 0 && service.Spec.ClusterIP != corev1.ClusterIPNone {
+			byNamespace[service.Namespace] = append(byNamespace[service.Namespace], service)
+		}
+	}
+
+	namespaces := make([]string, 0, len(byNamespace))
+	for ns := range byNamespace {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+
+	selectorOverlaps := []map[string]interface{}{}
+	for _, ns := range namespaces {
+		nsServices := byNamespace[ns]
+		if len(nsServices) < 2 {
+			continue
+		}
+
+		pods, err := c.clientset.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pods in namespace '%s': %v", ns, err)
+		}
+
+		selected := make([]map[string]bool, len(nsServices))
+		for i, service := range nsServices {
+			selected[i] = map[string]bool{}
+			selector := labels.SelectorFromSet(service.Spec.Selector)
+			for _, pod := range pods.Items {
+				if selector.Matches(labels.Set(pod.Labels)) {
+					selected[i][pod.Name] = true
+				}
+			}
+		}
+
+		for i := 0; i < len(nsServices); i++ {
+			for j := i + 1; j < len(nsServices); j++ {
+				var sharedPods []string
+				for podName := range selected[i] {
+					if selected[j][podName] {
+						sharedPods = append(sharedPods, podName)
+					}
+				}
+				if len(sharedPods) == 0 {
+					continue
+				}
+
+				sharedTargets := sharedTargetPorts(nsServices[i], nsServices[j])
+				if len(sharedTargets) == 0 {
+					continue
+				}
+
+				sort.Strings(sharedPods)
+				if len(sharedPods) > 5 {
+					sharedPods = sharedPods[:5]
+				}
+				selectorOverlaps = append(selectorOverlaps, map[string]interface{}{
+					"namespace":         ns,
+					"services":          []string{nsServices[i].Name, nsServices[j].Name},
+					"sharedTargetPorts": sharedTargets,
+					"examplePods":       sharedPods,
+					"message":           fmt.Sprintf("Services '%s' and '%s' route to the same pods on the same target port(s)", nsServices[i].Name, nsServices[j].Name),
+				})
+			}
+		}
+	}
+
+	scope := namespace
+	if scope == "" {
+		scope = "all namespaces"
+	}
+
+	// The default NodePort range 30000-32767 holds 2768 ports
+	const defaultNodePortRangeSize = 2768
+
+	return map[string]interface{}{
+		"scope":             scope,
+		"servicesScanned":   len(services),
+		"nodePortConflicts": nodePortConflicts,
+		"duplicatePorts":    duplicatePorts,
+		"selectorOverlaps":  selectorOverlaps,
+		"conflictCount":     len(nodePortConflicts) + len(duplicatePorts) + len(selectorOverlaps),
+		"nodePortUsage": map[string]interface{}{
+			"used":                len(usedNodePorts),
+			"defaultRangeSize":    defaultNodePortRangeSize,
+			"defaultRangePercent": float64(len(usedNodePorts)*10000/defaultNodePortRangeSize) / 100,
+		},
+	}, nil
+}
+
+func ownersInNamespace(owners []string, namespace string) bool {
+	for _, owner := range owners {
+		if strings.HasPrefix(owner, namespace+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func portConflictEntry(service corev1.Service, conflictType, message, severity string) map[string]interface{} {
+	return map[string]interface{}{
+		"namespace": service.Namespace,
+		"service":   service.Name,
+		"type":      conflictType,
+		"severity":  severity,
+		"message":   message,
+	}
+}
+
+// sharedTargetPorts returns the target ports (with protocol) both services forward to
+func sharedTargetPorts(a, b corev1.Service) []string {
+	targets := map[string]bool{}
+	for _, port := range a.Spec.Ports {
+		targets[port.TargetPort.String()+"/"+string(port.Protocol)] = true
+	}
+
+	var shared []string
+	for _, port := range b.Spec.Ports {
+		key := port.TargetPort.String() + "/" + string(port.Protocol)
+		if targets[key] {
+			shared = append(shared, key)
+			delete(targets, key)
+		}
+	}
+	sort.Strings(shared)
+	return shared
+}
+
+// CreateServiceFromPods creates a service from pod selector
+func (c *Client) CreateServiceFromPods(ctx context.Context, serviceName, namespace, labelSelector string, port, targetPort int32, serviceType string) (*corev1.Service, error) {
+	if namespace == "" {
+		namespace = "default"
+	}
+	if targetPort == 0 {
+		targetPort = port
+	}
+	if serviceType == "" {
+		serviceType = "ClusterIP"
+	}
+
+	// Parse label selector
+	selector, err := metav1.ParseToLabelSelector(labelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector '%s': %v", labelSelector, err)
+	}
+
+	// Create service
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      serviceName,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/name":       serviceName,
+				"app.kubernetes.io/created-by": "k8s-mcp-server",
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Type:     corev1.ServiceType(serviceType),
+			Selector: selector.MatchLabels,
+			Ports: []corev1.ServicePort{
+				{
+					Port:       port,
+					TargetPort: intstr.FromInt(int(targetPort)),
+					Protocol:   corev1.ProtocolTCP,
+				},
+			},
+		},
+	}
+
+	createdService, err := c.clientset.CoreV1().Services(namespace).Create(ctx, service, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create service '%s': %v", serviceName, err)
+	}
+
+	return createdService, nil
+}
+
+// ========== ADMISSION WEBHOOK OPERATIONS ==========
+
+// ListWebhooks lists validating and mutating admission webhooks, optionally filtered by the resource they intercept
+func (c *Client) ListWebhooks(ctx context.Context, webhookType, resource string) (map[string]interface{}, error) {
+	if webhookType == "" {
+		webhookType = "all"
+	}
+	if webhookType != "all" && webhookType != "validating" && webhookType != "mutating" {
+		return nil, fmt.Errorf("invalid webhook type '%s': must be 'validating', 'mutating' or 'all'", webhookType)
+	}
+
+	result := map[string]interface{}{
+		"type":                webhookType,
+		"resource":            resource,
+		"validatingWebhooks":  []map[string]interface{}{},
+		"mutatingWebhooks":    []map[string]interface{}{},
+		"totalConfigurations": 0,
+		"totalWebhooks":       0,
+	}
+
+	totalConfigurations := 0
+	totalWebhooks := 0
+
+	if webhookType == "all" || webhookType == "validating" {
+		configs, err := c.clientset.AdmissionregistrationV1().ValidatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list validating webhook configurations: %v", err)
+		}
+
+		validating := []map[string]interface{}{}
+		for _, config := range configs.Items {
+			var webhooks []map[string]interface{}
+			for _, webhook := range config.Webhooks {
+				if resource != "" && !webhookRulesMatchResource(webhook.Rules, resource) {
+					continue
+				}
+				webhookInfo := map[string]interface{}{
+					"name":              webhook.Name,
+					"rules":             getWebhookRules(webhook.Rules),
+					"failurePolicy":     webhookFailurePolicy(webhook.FailurePolicy),
+					"sideEffects":       webhookSideEffects(webhook.SideEffects),
+					"timeoutSeconds":    webhook.TimeoutSeconds,
+					"namespaceSelector": webhook.NamespaceSelector,
+					"objectSelector":    webhook.ObjectSelector,
+					"backend":           getWebhookBackend(webhook.ClientConfig),
+				}
+				webhooks = append(webhooks, webhookInfo)
+			}
+			if len(webhooks) == 0 {
+				continue
+			}
+
+			validating = append(validating, map[string]interface{}{
+				"configuration":     config.Name,
+				"creationTimestamp": config.CreationTimestamp.Time.Format(time.RFC3339),
+				"webhooks":          webhooks,
+			})
+			totalConfigurations++
+			totalWebhooks += len(webhooks)
+		}
+		result["validatingWebhooks"] = validating
+	}
+
+	if webhookType == "all" || webhookType == "mutating" {
+		configs, err := c.clientset.AdmissionregistrationV1().MutatingWebhookConfigurations().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list mutating webhook configurations: %v", err)
+		}
+
+		mutating := []map[string]interface{}{}
+		for _, config := range configs.Items {
+			var webhooks []map[string]interface{}
+			for _, webhook := range config.Webhooks {
+				if resource != "" && !webhookRulesMatchResource(webhook.Rules, resource) {
+					continue
+				}
+				reinvocationPolicy := ""
+				if webhook.ReinvocationPolicy != nil {
+					reinvocationPolicy = string(*webhook.ReinvocationPolicy)
+				}
+				webhookInfo := map[string]interface{}{
+					"name":               webhook.Name,
+					"rules":              getWebhookRules(webhook.Rules),
+					"failurePolicy":      webhookFailurePolicy(webhook.FailurePolicy),
+					"sideEffects":        webhookSideEffects(webhook.SideEffects),
+					"reinvocationPolicy": reinvocationPolicy,
+					"timeoutSeconds":     webhook.TimeoutSeconds,
+					"namespaceSelector":  webhook.NamespaceSelector,
+					"objectSelector":     webhook.ObjectSelector,
+					"backend":            getWebhookBackend(webhook.ClientConfig),
+				}
+				webhooks = append(webhooks, webhookInfo)
+			}
+			if len(webhooks) == 0 {
+				continue
+			}
+
+			mutating = append(mutating, map[string]interface{}{
+				"configuration":     config.Name,
+				"creationTimestamp": config.CreationTimestamp.Time.Format(time.RFC3339),
+				"webhooks":          webhooks,
+			})
+			totalConfigurations++
+			totalWebhooks += len(webhooks)
+		}
+		result["mutatingWebhooks"] = mutating
+	}
+
+	result["totalConfigurations"] = totalConfigurations
+	result["totalWebhooks"] = totalWebhooks
+	return result, nil
+}
+
+// Webhook helper functions
+func getWebhookRules(rules []admissionregistrationv1.RuleWithOperations) []map[string]interface{} {
+	var ruleList []map[string]interface{}
+	for _, rule := range rules {
+		operations := []string{}
+		for _, op := range rule.Operations {
+			operations = append(operations, string(op))
+		}
+		scope := "*"
+		if rule.Scope != nil {
+			scope = string(*rule.Scope)
+		}
+		ruleList = append(ruleList, map[string]interface{}{
+			"operations":  operations,
+			"apiGroups":   rule.APIGroups,
+			"apiVersions": rule.APIVersions,
+			"resources":   rule.Resources,
+			"scope":       scope,
+		})
+	}
+	return ruleList
+}
+
+// webhookRulesMatchResource reports whether any rule intercepts a resource, given as "pods" or as a subresource such as "pods/exec".
+// Wildcards follow the API server: "*" matches every top-level resource, "pods/*" every subresource of pods,
+// "*/status" the status subresource of everything, and "*/*" matches anything.
+func webhookRulesMatchResource(rules []admissionregistrationv1.RuleWithOperations, resource string) bool {
+	wantResource, wantSubresource, wantHasSub := strings.Cut(strings.ToLower(resource), "/")
+	for _, rule := range rules {
+		for _, r := range rule.Resources {
+			pattern := strings.ToLower(r)
+			if pattern == "*/*" {
+				return true
+			}
+			ruleResource, ruleSubresource, ruleHasSub := strings.Cut(pattern, "/")
+			if ruleHasSub != wantHasSub {
+				continue
+			}
+			if ruleResource != "*" && ruleResource != wantResource {
+				continue
+			}
+			if ruleHasSub && ruleSubresource != "*" && ruleSubresource != wantSubresource {
+				continue
+			}
+			return true
+		}
+	}
+	return false
+}
+
+func webhookFailurePolicy(policy *admissionregistrationv1.FailurePolicyType) string {
+	if policy == nil {
+		return string(admissionregistrationv1.Fail)
+	}
+	return string(*policy)
+}
+
+func webhookSideEffects(sideEffects *admissionregistrationv1.SideEffectClass) string {
+	if sideEffects == nil {
+		return ""
+	}
+	return string(*sideEffects)
+}
+
+func getWebhookBackend(clientConfig admissionregistrationv1.WebhookClientConfig) map[string]interface{} {
+	backend := map[string]interface{}{}
+	if clientConfig.Service != nil {
+		port := int32(443)
+		if clientConfig.Service.Port != nil {
+			port = *clientConfig.Service.Port
+		}
+		path := ""
+		if clientConfig.Service.Path != nil {
+			path = *clientConfig.Service.Path
+		}
+		backend["type"] = "service"
+		backend["service"] = fmt.Sprintf("%s/%s", clientConfig.Service.Namespace, clientConfig.Service.Name)
+		backend["port"] = port
+		backend["path"] = path
+	} else if clientConfig.URL != nil {
+		backend["type"] = "url"
+		backend["url"] = *clientConfig.URL
+	}
+	return backend
+}
+
+// ========== CRONJOB OPERATIONS ==========
+
+// ListCronJobs lists cronjobs in a namespace with their schedule and last run information
+func (c *Client) ListCronJobs(ctx context.Context, namespace string) ([]map[string]interface{}, error) {
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	cronJobs, err := c.clientset.BatchV1().CronJobs(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cronjobs in namespace '%s': %v", namespace, err)
+	}
+
+	result := []map[string]interface{}{}
+	for _, cronJob := range cronJobs.Items {
+		suspended := cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend
+
+		cronJobInfo := map[string]interface{}{
+			"name":       cronJob.Name,
+			"namespace":  cronJob.Namespace,
+			"schedule":   cronJob.Spec.Schedule,
+			"suspended":  suspended,
+			"activeJobs": len(cronJob.Status.Active),
+			"age":        time.Since(cronJob.CreationTimestamp.Time).Round(time.Second).String(),
+		}
+		if cronJob.Spec.TimeZone != nil {
+			cronJobInfo["timeZone"] = *cronJob.Spec.TimeZone
+		}
+		if cronJob.Status.LastScheduleTime != nil {
+			cronJobInfo["lastScheduleTime"] = cronJob.Status.LastScheduleTime.Time
+		}
+		if cronJob.Status.LastSuccessfulTime != nil {
+			cronJobInfo["lastSuccessfulTime"] = cronJob.Status.LastSuccessfulTime.Time
+		}
+
+		result = append(result, cronJobInfo)
+	}
+
+	return result, nil
+}
+
+// TriggerCronJob creates a one-off Job from a cronjob's template, optionally overriding command, args and env of a container
+func (c *Client) TriggerCronJob(ctx context.Context, namespace, name, containerName string, command, args []string, env map[string]string) (map[string]interface{}, error) {
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	cronJob, err := c.clientset.BatchV1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cronjob '%s' in namespace '%s': %v", name, namespace, err)
+	}
+
+	template := cronJob.Spec.JobTemplate
+	podSpec := template.Spec.Template.Spec.DeepCopy()
+
+	overridden := command != nil || args != nil || len(env) > 0
+	if overridden {
+		if len(podSpec.Containers) == 0 {
+			return nil, fmt.Errorf("cronjob '%s' has no containers to override", name)
+		}
+
+		target := -1
+		if containerName == "" {
+			target = 0
+		} else {
+			for i, container := range podSpec.Containers {
+				if container.Name == containerName {
+					target = i
+					break
+				}
+			}
+		}
+		if target < 0 {
+			return nil, fmt.Errorf("container '%s' not found in cronjob '%s'", containerName, name)
+		}
+
+		container := &podSpec.Containers[target]
+		containerName = container.Name
+		if command != nil {
+			container.Command = command
+		}
+		if args != nil {
+			container.Args = args
+		}
+
+		// Override existing variables in place and append new ones in a stable order
+		envNames := make([]string, 0, len(env))
+		for envName := range env {
+			envNames = append(envNames, envName)
+		}
+		sort.Strings(envNames)
+		for _, envName := range envNames {
+			replaced := false
+			for i := range container.Env {
+				if container.Env[i].Name == envName {
+					container.Env[i] = corev1.EnvVar{Name: envName, Value: env[envName]}
+					replaced = true
+					break
+				}
+			}
+			if !replaced {
+				container.Env = append(container.Env, corev1.EnvVar{Name: envName, Value: env[envName]})
+			}
+		}
+	}
+
+	annotations := map[string]string{}
+	for k, v := range template.Annotations {
+		annotations[k] = v
+	}
+	annotations["cronjob.kubernetes.io/instantiate"] = "manual"
+	if overridden {
+		annotations["simple-k8s-mcp-server/overridden-container"] = containerName
+	}
+
+	jobLabels := map[string]string{}
+	for k, v := range template.Labels {
+		jobLabels[k] = v
+	}
+
+	// The API server appends a random suffix (and trims the prefix to fit), so repeated triggers never collide
+	isController := true
+	blockOwnerDeletion := true
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: name + "-manual-",
+			Namespace:    namespace,
+			Labels:       jobLabels,
+			Annotations:  annotations,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         "batch/v1",
+					Kind:               "CronJob",
+					Name:               cronJob.Name,
+					UID:                cronJob.UID,
+					Controller:         &isController,
+					BlockOwnerDeletion: &blockOwnerDeletion,
+				},
+			},
+		},
+		Spec: *template.Spec.DeepCopy(),
+	}
+	job.Spec.Template.Spec = *podSpec
+
+	createdJob, err := c.clientset.BatchV1().Jobs(namespace).Create(ctx, job, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create job from cronjob '%s': %v", name, err)
+	}
+
+	result := map[string]interface{}{
+		"jobName":    createdJob.Name,
+		"namespace":  createdJob.Namespace,
+		"cronJob":    name,
+		"overridden": overridden,
+		"createdAt":  createdJob.CreationTimestamp.Time,
+	}
+	if overridden {
+		overrides := map[string]interface{}{
+			"container": containerName,
+		}
+		if command != nil {
+			overrides["command"] = command
+		}
+		if args != nil {
+			overrides["args"] = args
+		}
+		if len(env) > 0 {
+			overrides["env"] = env
+		}
+		result["overrides"] = overrides
+	}
+
+	return result, nil
+}
+
+// ========== GENERIC RESOURCE OPERATIONS ==========
+
+// resourceKind describes a Kubernetes kind that can be handled through the dynamic client
+type resourceKind struct {
+	Kind       string
+	APIVersion string
+	Resource   schema.GroupVersionResource
+	Namespaced bool
+	ShortNames []string
+}
+
+// supportedKinds lists the kinds accepted by the generic (kind-based) tools
+var supportedKinds = []resourceKind{
+	{Kind: "Pod", APIVersion: "v1", Resource: schema.GroupVersionResource{Version: "v1", Resource: "pods"}, Namespaced: true, ShortNames: []string{"po"}},
+	{Kind: "Service", APIVersion: "v1", Resource: schema.GroupVersionResource{Version: "v1", Resource: "services"}, Namespaced: true, ShortNames: []string{"svc"}},
+	{Kind: "ConfigMap", APIVersion: "v1", Resource: schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}, Namespaced: true, ShortNames: []string{"cm"}},
+	{Kind: "Secret", APIVersion: "v1", Resource: schema.GroupVersionResource{Version: "v1", Resource: "secrets"}, Namespaced: true},
+	{Kind: "PersistentVolumeClaim", APIVersion: "v1", Resource: schema.GroupVersionResource{Version: "v1", Resource: "persistentvolumeclaims"}, Namespaced: true, ShortNames: []string{"pvc"}},
+	{Kind: "ServiceAccount", APIVersion: "v1", Resource: schema.GroupVersionResource{Version: "v1", Resource: "serviceaccounts"}, Namespaced: true, ShortNames: []string{"sa"}},
+	{Kind: "ResourceQuota", APIVersion: "v1", Resource: schema.GroupVersionResource{Version: "v1", Resource: "resourcequotas"}, Namespaced: true, ShortNames: []string{"quota"}},
+	{Kind: "LimitRange", APIVersion: "v1", Resource: schema.GroupVersionResource{Version: "v1", Resource: "limitranges"}, Namespaced: true, ShortNames: []string{"limits"}},
+	{Kind: "Deployment", APIVersion: "apps/v1", Resource: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}, Namespaced: true, ShortNames: []string{"deploy"}},
+	{Kind: "StatefulSet", APIVersion: "apps/v1", Resource: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "statefulsets"}, Namespaced: true, ShortNames: []string{"sts"}},
+	{Kind: "DaemonSet", APIVersion: "apps/v1", Resource: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "daemonsets"}, Namespaced: true, ShortNames: []string{"ds"}},
+	{Kind: "ReplicaSet", APIVersion: "apps/v1", Resource: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "replicasets"}, Namespaced: true, ShortNames: []string{"rs"}},
+	{Kind: "Job", APIVersion: "batch/v1", Resource: schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "jobs"}, Namespaced: true},
+	{Kind: "CronJob", APIVersion: "batch/v1", Resource: schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "cronjobs"}, Namespaced: true, ShortNames: []string{"cj"}},
+	{Kind: "Ingress", APIVersion: "networking.k8s.io/v1", Resource: schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "ingresses"}, Namespaced: true, ShortNames: []string{"ing"}},
+	{Kind: "NetworkPolicy", APIVersion: "networking.k8s.io/v1", Resource: schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "networkpolicies"}, Namespaced: true, ShortNames: []string{"netpol"}},
+	{Kind: "HorizontalPodAutoscaler", APIVersion: "autoscaling/v2", Resource: schema.GroupVersionResource{Group: "autoscaling", Version: "v2", Resource: "horizontalpodautoscalers"}, Namespaced: true, ShortNames: []string{"hpa"}},
+	{Kind: "PodDisruptionBudget", APIVersion: "policy/v1", Resource: schema.GroupVersionResource{Group: "policy", Version: "v1", Resource: "poddisruptionbudgets"}, Namespaced: true, ShortNames: []string{"pdb"}},
+	{Kind: "Role", APIVersion: "rbac.authorization.k8s.io/v1", Resource: schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "roles"}, Namespaced: true},
+	{Kind: "RoleBinding", APIVersion: "rbac.authorization.k8s.io/v1", Resource: schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "rolebindings"}, Namespaced: true},
+	{Kind: "Namespace", APIVersion: "v1", Resource: schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}, ShortNames: []string{"ns"}},
+	{Kind: "Node", APIVersion: "v1", Resource: schema.GroupVersionResource{Version: "v1", Resource: "nodes"}, ShortNames: []string{"no"}},
+	{Kind: "PersistentVolume", APIVersion: "v1", Resource: schema.GroupVersionResource{Version: "v1", Resource: "persistentvolumes"}, ShortNames: []string{"pv"}},
+	{Kind: "StorageClass", APIVersion: "storage.k8s.io/v1", Resource: schema.GroupVersionResource{Group: "storage.k8s.io", Version: "v1", Resource: "storageclasses"}, ShortNames: []string{"sc"}},
+	{Kind: "ClusterRole", APIVersion: "rbac.authorization.k8s.io/v1", Resource: schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles"}},
+	{Kind: "ClusterRoleBinding", APIVersion: "rbac.authorization.k8s.io/v1", Resource: schema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterrolebindings"}},
+}
+
+// resolveKind finds a supported kind by kind name, plural resource name or short name (case-insensitive)
+func resolveKind(kind string) (resourceKind, error) {
+	kind = strings.ToLower(strings.TrimSpace(kind))
+	for _, rk := range supportedKinds {
+		if strings.ToLower(rk.Kind) == kind || rk.Resource.Resource == kind {
+			return rk, nil
+		}
+		for _, short := range rk.ShortNames {
+			if short == kind {
+				return rk, nil
+			}
+		}
+	}
+
+	var names []string
+	for _, rk := range supportedKinds {
+		names = append(names, rk.Kind)
+	}
+	return resourceKind{}, fmt.Errorf("unsupported kind '%s' (supported: %s)", kind, strings.Join(names, ", "))
+}
+
+// resourceInterface returns the dynamic client interface for a kind, scoped to the namespace when the kind is namespaced
+func (c *Client) resourceInterface(rk resourceKind, namespace string) dynamic.ResourceInterface {
+	if rk.Namespaced {
+		return c.dynamicClient.Resource(rk.Resource).Namespace(namespace)
+	}
+	return c.dynamicClient.Resource(rk.Resource)
+}
+
+// sanitizeForExport removes cluster-specific fields so the object can be re-applied elsewhere.
+// It returns a description of each notable field it removed; bookkeeping metadata is removed silently.
+func sanitizeForExport(obj *unstructured.Unstructured) []string {
+	unstructured.RemoveNestedField(obj.Object, "metadata", "uid")
+	unstructured.RemoveNestedField(obj.Object, "metadata", "resourceVersion")
+	unstructured.RemoveNestedField(obj.Object, "metadata", "generation")
+	unstructured.RemoveNestedField(obj.Object, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(obj.Object, "metadata", "selfLink")
+	unstructured.RemoveNestedField(obj.Object, "metadata", "managedFields")
+	unstructured.RemoveNestedField(obj.Object, "status")
+
+	var rewrites []string
+	if len(obj.GetOwnerReferences()) > 0 {
+		obj.SetOwnerReferences(nil)
+		rewrites = append(rewrites, "removed metadata.ownerReferences (owner UIDs do not carry over)")
+	}
+
+	switch obj.GetKind() {
+	case "Service":
+		// Headless services keep clusterIP: None, otherwise they come back as regular ClusterIP services
+		if clusterIP, _, _ := unstructured.NestedString(obj.Object, "spec", "clusterIP"); clusterIP != corev1.ClusterIPNone {
+			unstructured.RemoveNestedField(obj.Object, "spec", "clusterIP")
+			unstructured.RemoveNestedField(obj.Object, "spec", "clusterIPs")
+		}
+
+		// Node ports are cluster-wide, so the copy must let the API server allocate new ones
+		ports, _, _ := unstructured.NestedSlice(obj.Object, "spec", "ports")
+		for i, item := range ports {
+			port, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if nodePort, exists := port["nodePort"]; exists {
+				delete(port, "nodePort")
+				rewrites = append(rewrites, fmt.Sprintf("removed spec.ports[%d].nodePort %v (node ports are allocated cluster-wide)", i, nodePort))
+			}
+		}
+		if ports != nil {
+			unstructured.SetNestedSlice(obj.Object, ports, "spec", "ports")
+		}
+		if healthCheckNodePort, found, _ := unstructured.NestedFieldNoCopy(obj.Object, "spec", "healthCheckNodePort"); found {
+			unstructured.RemoveNestedField(obj.Object, "spec", "healthCheckNodePort")
+			rewrites = append(rewrites, fmt.Sprintf("removed spec.healthCheckNodePort %v (node ports are allocated cluster-wide)", healthCheckNodePort))
+		}
+	case "PersistentVolumeClaim":
+		if volumeName, found, _ := unstructured.NestedString(obj.Object, "spec", "volumeName"); found && volumeName != "" {
+			unstructured.RemoveNestedField(obj.Object, "spec", "volumeName")
+			rewrites = append(rewrites, fmt.Sprintf("removed spec.volumeName '%s' (the volume stays bound to the source claim)", volumeName))
+		}
+		// Left in place, the binding annotations make the PV controller mark the copy as Lost
+		if annotations := obj.GetAnnotations(); annotations != nil {
+			for _, key := range []string{"pv.kubernetes.io/bind-completed", "pv.kubernetes.io/bound-by-controller", "volume.kubernetes.io/selected-node"} {
+				if _, exists := annotations[key]; exists {
+					delete(annotations, key)
+					rewrites = append(rewrites, fmt.Sprintf("removed %s annotation", key))
+				}
+			}
+			obj.SetAnnotations(annotations)
+		}
+	}
+	return rewrites
+}
+
+// isSystemManagedResource reports objects created automatically by Kubernetes itself
+func isSystemManagedResource(obj *unstructured.Unstructured) bool {
+	name := obj.GetName()
+	if strings.HasPrefix(name, "system:") {
+		return true
+	}
+	if obj.GetLabels()["kubernetes.io/bootstrapping"] != "" {
+		return true
+	}
+
+	switch obj.GetKind() {
+	case "Namespace":
+		return name == "kube-system" || name == "kube-public" || name == "kube-node-lease"
+	case "ConfigMap":
+		return name == "kube-root-ca.crt"
+	case "ServiceAccount":
+		return name == "default"
+	case "Secret":
+		secretType, _, _ := unstructured.NestedString(obj.Object, "type")
+		return secretType == string(corev1.SecretTypeServiceAccountToken)
+	case "Service":
+		return name == "kubernetes" && obj.GetNamespace() == "default"
+	}
+	return false
+}
+
+// ExportKind exports every resource of a kind as a sanitized multi-document YAML bundle
+func (c *Client) ExportKind(ctx context.Context, kind, namespace string, includeSystem bool, excludeSelector string) (map[string]interface{}, error) {
+	rk, err := resolveKind(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	var exclude labels.Selector
+	if excludeSelector != "" {
+		exclude, err = labels.Parse(excludeSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exclude selector '%s': %v", excludeSelector, err)
+		}
+	}
+
+	if rk.Namespaced && namespace == "" {
+		namespace = "default"
+	}
+
+	list, err := c.resourceInterface(rk, namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s: %v", rk.Resource.Resource, err)
+	}
+
+	sort.Slice(list.Items, func(i, j int) bool {
+		if list.Items[i].GetNamespace() != list.Items[j].GetNamespace() {
+			return list.Items[i].GetNamespace() < list.Items[j].GetNamespace()
+		}
+		return list.Items[i].GetName() < list.Items[j].GetName()
+	})
+
+	var documents []string
+	var exported []string
+	var skipped []map[string]interface{}
+
+	for i := range list.Items {
+		obj := &list.Items[i]
+		ref := obj.GetName()
+		if obj.GetNamespace() != "" {
+			ref = fmt.Sprintf("%s/%s", obj.GetNamespace(), obj.GetName())
+		}
+
+		if !includeSystem && isSystemManagedResource(obj) {
+			skipped = append(skipped, map[string]interface{}{"name": ref, "reason": "system resource"})
+			continue
+		}
+		if exclude != nil && exclude.Matches(labels.Set(obj.GetLabels())) {
+			skipped = append(skipped, map[string]interface{}{"name": ref, "reason": "matched exclude selector"})
+			continue
+		}
+		// Controller-owned objects (pods of a ReplicaSet, ReplicaSets of a Deployment) are recreated by their owner
+		if owner := metav1.GetControllerOfNoCopy(obj); owner != nil {
+			skipped = append(skipped, map[string]interface{}{"name": ref, "reason": fmt.Sprintf("managed by %s '%s'", owner.Kind, owner.Name)})
+			continue
+		}
+
+		obj.SetAPIVersion(rk.APIVersion)
+		obj.SetKind(rk.Kind)
+		sanitizeForExport(obj)
+
+		yamlData, err := sigsyaml.Marshal(obj.Object)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal %s '%s' to YAML: %v", rk.Kind, ref, err)
+		}
+
+		documents = append(documents, string(yamlData))
+		exported = append(exported, ref)
+	}
+
+	scope := "cluster"
+	if rk.Namespaced {
+		scope = namespace
+	}
+
+	result := map[string]interface{}{
+		"kind":          rk.Kind,
+		"apiVersion":    rk.APIVersion,
+		"scope":         scope,
+		"includeSystem": includeSystem,
+		"exported":      exported,
+		"exportedCount": len(exported),
+		"skipped":       skipped,
+		"skippedCount":  len(skipped),
+		"yaml":          strings.Join(documents, "---\n"),
+	}
+
+	return result, nil
+}
+
+// lastAppliedAnnotation is written by 'kubectl apply' with the configuration it last applied
+const lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// DriftFromLastApplied compares a live resource against its last-applied-configuration annotation
+func (c *Client) DriftFromLastApplied(ctx context.Context, kind, name, namespace string) (map[string]interface{}, error) {
+	rk, err := resolveKind(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	if rk.Namespaced && namespace == "" {
+		namespace = "default"
+	}
+
+	obj, err := c.resourceInterface(rk, namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s '%s': %v", rk.Kind, name, err)
+	}
+
+	result := map[string]interface{}{
+		"kind":      rk.Kind,
+		"name":      name,
+		"namespace": obj.GetNamespace(),
+	}
+
+	lastApplied, exists := obj.GetAnnotations()[lastAppliedAnnotation]
+	if !exists || lastApplied == "" {
+		result["hasLastApplied"] = false
+		result["drifted"] = false
+		result["drifts"] = []map[string]interface{}{}
+		result["message"] = fmt.Sprintf("%s '%s' has no %s annotation (not managed by 'kubectl apply')", rk.Kind, name, lastAppliedAnnotation)
+		return result, nil
+	}
+
+	var applied map[string]interface{}
+	if err := json.Unmarshal([]byte(lastApplied), &applied); err != nil {
+		return nil, fmt.Errorf("failed to parse last-applied-configuration of %s '%s': %v", rk.Kind, name, err)
+	}
+
+	// Round-trip the live object through JSON so numbers compare the same way on both sides
+	var live map[string]interface{}
+	liveJSON, err := json.Marshal(obj.Object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize %s '%s': %v", rk.Kind, name, err)
+	}
+	if err := json.Unmarshal(liveJSON, &live); err != nil {
+		return nil, fmt.Errorf("failed to parse %s '%s': %v", rk.Kind, name, err)
+	}
+
+	// The annotation never contains itself, and status is owned by controllers
+	delete(applied, "status")
+	if metadata, ok := applied["metadata"].(map[string]interface{}); ok {
+		if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+			delete(annotations, lastAppliedAnnotation)
+		}
+	}
+
+	drifts := []map[string]interface{}{}
+	collectDrift("", applied, live, &drifts)
+
+	result["hasLastApplied"] = true
+	result["drifted"] = len(drifts) > 0
+	result["driftCount"] = len(drifts)
+	result["drifts"] = drifts
+	return result, nil
+}
+
+// collectDrift walks the applied value and records every path whose live value differs or is missing, plus named list
+// items (containers, env vars) that exist only live
+func collectDrift(path string, applied, live interface{}, drifts *[]map[string]interface{}) {
+	switch appliedValue := applied.(type) {
+	case map[string]interface{}:
+		liveMap, ok := live.(map[string]interface{})
+		if !ok {
+			*drifts = append(*drifts, driftEntry(path, "changed", applied, live))
+			return
+		}
+
+		keys := make([]string, 0, len(appliedValue))
+		for key := range appliedValue {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			childPath := key
+			if path != "" {
+				childPath = path + "." + key
+			}
+			liveChild, exists := liveMap[key]
+			if !exists {
+				if appliedValue[key] != nil {
+					*drifts = append(*drifts, driftEntry(childPath, "removed", appliedValue[key], nil))
+				}
+				continue
+			}
+			collectDrift(childPath, appliedValue[key], liveChild, drifts)
+		}
+
+	case []interface{}:
+		liveList, ok := live.([]interface{})
+		if !ok {
+			*drifts = append(*drifts, driftEntry(path, "changed", applied, live))
+			return
+		}
+
+		// Lists of named items (containers, ports, env, volumes) are matched by name rather than position
+		if liveByName, ok := indexByName(liveList); ok {
+			if appliedByName, ok := indexByName(appliedValue); ok {
+				names := make([]string, 0, len(appliedByName))
+				for itemName := range appliedByName {
+					names = append(names, itemName)
+				}
+				sort.Strings(names)
+
+				for _, itemName := range names {
+					childPath := fmt.Sprintf("%s[name=%s]", path, itemName)
+					liveItem, exists := liveByName[itemName]
+					if !exists {
+						*drifts = append(*drifts, driftEntry(childPath, "removed", appliedByName[itemName], nil))
+						continue
+					}
+					collectDrift(childPath, appliedByName[itemName], liveItem, drifts)
+				}
+
+				// Items added live (kubectl edit, injected sidecars) have no applied counterpart to walk from
+				var addedNames []string
+				for itemName := range liveByName {
+					if _, exists := appliedByName[itemName]; !exists {
+						addedNames = append(addedNames, itemName)
+					}
+				}
+				sort.Strings(addedNames)
+				for _, itemName := range addedNames {
+					*drifts = append(*drifts, driftEntry(fmt.Sprintf("%s[name=%s]", path, itemName), "added", nil, liveByName[itemName]))
+				}
+				return
+			}
+		}
+
+		if len(appliedValue) != len(liveList) {
+			*drifts = append(*drifts, driftEntry(path, "changed", applied, live))
+			return
+		}
+		for i := range appliedValue {
+			collectDrift(fmt.Sprintf("%s[%d]", path, i), appliedValue[i], liveList[i], drifts)
+		}
+
+	default:
+		if !driftValuesEqual(applied, live) {
+			*drifts = append(*drifts, driftEntry(path, "changed", applied, live))
+		}
+	}
+}
+
+// indexByName maps a list of objects by their "name" field, reporting false if any item lacks one
+func indexByName(items []interface{}) (map[string]interface{}, bool) {
+	if len(items) == 0 {
+		return nil, false
+	}
+
+	byName := make(map[string]interface{}, len(items))
+	for _, item := range items {
+		itemMap, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		itemName, ok := itemMap["name"].(string)
+		if !ok || itemName == "" {
+			return nil, false
+		}
+		byName[itemName] = item
+	}
+	return byName, true
+}
+
+// driftValuesEqual compares scalar values, treating equivalent quantities such as "0.5" and "500m" as equal
+func driftValuesEqual(applied, live interface{}) bool {
+	if reflect.DeepEqual(applied, live) {
+		return true
+	}
+
+	appliedStr, ok1 := applied.(string)
+	liveStr, ok2 := live.(string)
+	if ok1 && ok2 {
+		appliedQty, err1 := resource.ParseQuantity(appliedStr)
+		liveQty, err2 := resource.ParseQuantity(liveStr)
+		return err1 == nil && err2 == nil && appliedQty.Cmp(liveQty) == 0
+	}
+
+	return false
+}
+
+func driftEntry(path, change string, applied, live interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"path":        path,
+		"change":      change,
+		"lastApplied": applied,
+		"live":        live,
+	}
+}
+
+// MergeFields applies a partial object to a resource as a JSON merge patch, with strict server-side field validation
+func (c *Client) MergeFields(ctx context.Context, kind, name, namespace, fields string, dryRun bool) (map[string]interface{}, error) {
+	rk, err := resolveKind(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	if rk.Namespaced && namespace == "" {
+		namespace = "default"
+	}
+
+	var partial map[string]interface{}
+	if err := json.Unmarshal([]byte(fields), &partial); err != nil {
+		return nil, fmt.Errorf("fields must be a JSON object (e.g., '{\"spec\":{\"replicas\":3}}'): %v", err)
+	}
+	if len(partial) == 0 {
+		return nil, fmt.Errorf("fields must contain at least one field to change")
+	}
+
+	// Identity fields cannot be changed by a patch, so catch mistakes before they reach the API
+	if apiVersion, exists := partial["apiVersion"]; exists && apiVersion != rk.APIVersion {
+		return nil, fmt.Errorf("apiVersion '%v' does not match %s (%s)", apiVersion, rk.Kind, rk.APIVersion)
+	}
+	if k, exists := partial["kind"]; exists && k != rk.Kind {
+		return nil, fmt.Errorf("kind '%v' does not match %s", k, rk.Kind)
+	}
+	if _, exists := partial["status"]; exists {
+		return nil, fmt.Errorf("status cannot be changed with mergeFields (it is managed by controllers)")
+	}
+	if metadata, ok := partial["metadata"].(map[string]interface{}); ok {
+		for _, field := range []string{"name", "namespace", "uid", "resourceVersion", "creationTimestamp"} {
+			if _, exists := metadata[field]; exists {
+				return nil, fmt.Errorf("metadata.%s cannot be changed with mergeFields", field)
+			}
+		}
+	}
+
+	resourceClient := c.resourceInterface(rk, namespace)
+	before, err := resourceClient.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s '%s': %v", rk.Kind, name, err)
+	}
+
+	// A replicas change is a scale operation and must respect --max-replicas; repeating the live value is not
+	if replicas, found, _ := unstructured.NestedFieldNoCopy(partial, "spec", "replicas"); found {
+		if replicasFloat, ok := replicas.(float64); ok {
+			current, hasCurrent, _ := unstructured.NestedInt64(before.Object, "spec", "replicas")
+			if !hasCurrent || current != int64(replicasFloat) {
+				if err := c.checkReplicaLimit(rk.Kind, name, int32(replicasFloat)); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	patchOptions := metav1.PatchOptions{
+		FieldValidation: metav1.FieldValidationStrict,
+	}
+	if dryRun {
+		patchOptions.DryRun = []string{metav1.DryRunAll}
+	}
+
+	after, err := resourceClient.Patch(ctx, name, types.MergePatchType, []byte(fields), patchOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge fields into %s '%s': %v", rk.Kind, name, err)
+	}
+
+	var changed []map[string]interface{}
+	for _, path := range leafPaths(nil, partial) {
+		oldValue, _, _ := unstructured.NestedFieldNoCopy(before.Object, path...)
+		newValue, _, _ := unstructured.NestedFieldNoCopy(after.Object, path...)
+		if !reflect.DeepEqual(oldValue, newValue) {
+			changed = append(changed, map[string]interface{}{
+				"path":     strings.Join(path, "."),
+				"oldValue": oldValue,
+				"newValue": newValue,
+			})
+		}
+	}
+	if changed == nil {
+		changed = []map[string]interface{}{}
+	}
+
+	result := map[string]interface{}{
+		"kind":            rk.Kind,
+		"name":            name,
+		"namespace":       after.GetNamespace(),
+		"dryRun":          dryRun,
+		"patchType":       "merge",
+		"changedFields":   changed,
+		"changedCount":    len(changed),
+		"resourceVersion": after.GetResourceVersion(),
+	}
+	if after.GetGeneration() != before.GetGeneration() {
+		result["generation"] = after.GetGeneration()
+	}
+
+	return result, nil
+}
+
+// leafPaths lists the field paths of every non-object value in a partial object; lists and nulls count as leaves.
+// Paths are kept as segments because keys such as annotations often contain dots themselves.
+func leafPaths(prefix []string, value map[string]interface{}) [][]string {
+	keys := make([]string, 0, len(value))
+	for key := range value {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var paths [][]string
+	for _, key := range keys {
+		path := append(append([]string{}, prefix...), key)
+		if child, ok := value[key].(map[string]interface{}); ok && len(child) > 0 {
+			paths = append(paths, leafPaths(path, child)...)
+			continue
+		}
+		paths = append(paths, path)
+	}
+	return paths
+}
+
+// RebaseManifest rewrites a manifest (or a live resource) so it can be applied in another namespace
+func (c *Client) RebaseManifest(ctx context.Context, manifest, kind, name, sourceNamespace, targetNamespace string) (map[string]interface{}, error) {
+	if targetNamespace == "" {
+		return nil, fmt.Errorf("target namespace is required")
+	}
+
+	var objects []*unstructured.Unstructured
+	if strings.TrimSpace(manifest) != "" {
+		for i, document := range splitYAMLDocuments(manifest) {
+			jsonData, err := sigsyaml.YAMLToJSON([]byte(document))
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse manifest document %d: %v", i+1, err)
+			}
+			var object map[string]interface{}
+			if err := json.Unmarshal(jsonData, &object); err != nil {
+				return nil, fmt.Errorf("failed to parse manifest document %d: %v", i+1, err)
+			}
+			if len(object) == 0 {
+				continue
+			}
+			obj := &unstructured.Unstructured{Object: object}
+			if obj.GetKind() == "" || obj.GetName() == "" {
+				return nil, fmt.Errorf("manifest document %d must have kind and metadata.name", i+1)
+			}
+			if obj.GetNamespace() == "" && sourceNamespace != "" {
+				obj.SetNamespace(sourceNamespace)
+			}
+			objects = append(objects, obj)
+		}
+		if len(objects) == 0 {
+			return nil, fmt.Errorf("manifest does not contain any objects")
+		}
+	} else {
+		if kind == "" || name == "" {
+			return nil, fmt.Errorf("either a manifest or a kind and name of a live resource must be provided")
+		}
+		rk, err := resolveKind(kind)
+		if err != nil {
+			return nil, err
+		}
+		if sourceNamespace == "" {
+			sourceNamespace = "default"
+		}
+		obj, err := c.resourceInterface(rk, sourceNamespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s '%s': %v", rk.Kind, name, err)
+		}
+		obj.SetAPIVersion(rk.APIVersion)
+		obj.SetKind(rk.Kind)
+		objects = append(objects, obj)
+	}
+
+	var documents []string
+	var rebased []map[string]interface{}
+
+	for _, obj := range objects {
+		entry := rebaseObject(obj, targetNamespace)
+
+		// Report whether the same-namespace dependencies already exist where the copy will land
+		if dependencies, ok := entry["dependencies"].([]map[string]interface{}); ok {
+			for _, dependency := range dependencies {
+				exists, err := c.namespacedObjectExists(ctx, dependency["kind"].(string), dependency["name"].(string), targetNamespace)
+				if err == nil {
+					dependency["existsInTarget"] = exists
+				}
+			}
+		}
+
+		yamlData, err := sigsyaml.Marshal(obj.Object)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal %s '%s' to YAML: %v", obj.GetKind(), obj.GetName(), err)
+		}
+		documents = append(documents, string(yamlData))
+		rebased = append(rebased, entry)
+	}
+
+	result := map[string]interface{}{
+		"targetNamespace": targetNamespace,
+		"objects":         rebased,
+		"objectCount":     len(rebased),
+		"yaml":            strings.Join(documents, "---\n"),
+	}
+
+	return result, nil
+}
+
+// splitYAMLDocuments splits a multi-document YAML string on '---' separator lines
+func splitYAMLDocuments(manifest string) []string {
+	var documents []string
+	var current []string
+	for _, line := range strings.Split(manifest, "\n") {
+		if strings.TrimRight(line, " \t\r") == "---" {
+			documents = append(documents, strings.Join(current, "\n"))
+			current = nil
+			continue
+		}
+		current = append(current, line)
+	}
+	return append(documents, strings.Join(current, "\n"))
+}
+
+// rebaseObject moves a single object to the target namespace in place and describes what was rewritten
+func rebaseObject(obj *unstructured.Unstructured, targetNamespace string) map[string]interface{} {
+	sourceNamespace := obj.GetNamespace()
+	var rewrites []string
+	var warnings []string
+	dependencies := []map[string]interface{}{}
+
+	entry := map[string]interface{}{
+		"kind":            obj.GetKind(),
+		"name":            obj.GetName(),
+		"sourceNamespace": sourceNamespace,
+	}
+
+	if rk, err := resolveKind(obj.GetKind()); err == nil && !rk.Namespaced {
+		entry["rewrites"] = []string{}
+		entry["dependencies"] = dependencies
+		entry["warnings"] = []string{fmt.Sprintf("%s is cluster-scoped; it was left unchanged", rk.Kind)}
+		return entry
+	} else if err != nil {
+		warnings = append(warnings, fmt.Sprintf("kind '%s' is not recognized; only namespace-independent cleanup and metadata.namespace were rewritten", obj.GetKind()))
+	}
+
+	rewrites = append(rewrites, sanitizeForExport(obj)...)
+	if annotations := obj.GetAnnotations(); annotations[lastAppliedAnnotation] != "" {
+		delete(annotations, lastAppliedAnnotation)
+		obj.SetAnnotations(annotations)
+		rewrites = append(rewrites, "removed "+lastAppliedAnnotation+" annotation")
+	}
+
+	obj.SetNamespace(targetNamespace)
+	if sourceNamespace == "" {
+		rewrites = append(rewrites, fmt.Sprintf("metadata.namespace: set to '%s'", targetNamespace))
+		warnings = append(warnings, "source namespace unknown; in-cluster DNS names were not rewritten")
+	} else {
+		rewrites = append(rewrites, fmt.Sprintf("metadata.namespace: '%s' -> '%s'", sourceNamespace, targetNamespace))
+	}
+
+	switch obj.GetKind() {
+	case "RoleBinding":
+		subjects, _, _ := unstructured.NestedSlice(obj.Object, "subjects")
+		for i, item := range subjects {
+			subject, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if subjectNamespace, _ := subject["namespace"].(string); sourceNamespace != "" && subjectNamespace == sourceNamespace {
+				subject["namespace"] = targetNamespace
+				rewrites = append(rewrites, fmt.Sprintf("subjects[%d].namespace: '%s' -> '%s'", i, sourceNamespace, targetNamespace))
+			}
+		}
+		unstructured.SetNestedSlice(obj.Object, subjects, "subjects")
+
+		if roleKind, _, _ := unstructured.NestedString(obj.Object, "roleRef", "kind"); roleKind == "Role" {
+			roleName, _, _ := unstructured.NestedString(obj.Object, "roleRef", "name")
+			dependencies = append(dependencies, map[string]interface{}{"kind": "Role", "name": roleName, "reference": "roleRef"})
+		}
+	case "Service":
+		if externalName, found, _ := unstructured.NestedString(obj.Object, "spec", "externalName"); found && sourceNamespace != "" {
+			if rewritten, changed := rebaseServiceDNS(externalName, sourceNamespace, targetNamespace); changed {
+				unstructured.SetNestedField(obj.Object, rewritten, "spec", "externalName")
+				rewrites = append(rewrites, fmt.Sprintf("spec.externalName: '%s' -> '%s'", externalName, rewritten))
+			}
+		}
+	case "Ingress":
+		tls, _, _ := unstructured.NestedSlice(obj.Object, "spec", "tls")
+		for _, item := range tls {
+			if tlsEntry, ok := item.(map[string]interface{}); ok {
+				if secretName, _ := tlsEntry["secretName"].(string); secretName != "" {
+					dependencies = append(dependencies, map[string]interface{}{"kind": "Secret", "name": secretName, "reference": "spec.tls.secretName"})
+				}
+			}
+		}
+	case "NetworkPolicy":
+		if sourceNamespace != "" && strings.Contains(fmt.Sprint(obj.Object["spec"]), sourceNamespace) {
+			warnings = append(warnings, fmt.Sprintf("spec mentions '%s'; review namespaceSelectors, they were not rewritten", sourceNamespace))
+		}
+	}
+
+	if specPath := podSpecPath(obj.GetKind()); specPath != nil {
+		if rawSpec, found, _ := unstructured.NestedMap(obj.Object, specPath...); found {
+			// Env values often carry cluster DNS names such as 'db.<namespace>.svc.cluster.local'
+			if sourceNamespace != "" {
+				for _, field := range []string{"initContainers", "containers"} {
+					containers, _, _ := unstructured.NestedSlice(rawSpec, field)
+					for _, item := range containers {
+						container, ok := item.(map[string]interface{})
+						if !ok {
+							continue
+						}
+						envVars, _ := container["env"].([]interface{})
+						for _, envItem := range envVars {
+							env, ok := envItem.(map[string]interface{})
+							if !ok {
+								continue
+							}
+							value, _ := env["value"].(string)
+							if rewritten, changed := rebaseServiceDNS(value, sourceNamespace, targetNamespace); changed {
+								env["value"] = rewritten
+								rewrites = append(rewrites, fmt.Sprintf("%s[%v].env[%v]: '%s' -> '%s'", field, container["name"], env["name"], value, rewritten))
+							}
+						}
+					}
+					if containers != nil {
+						unstructured.SetNestedSlice(rawSpec, containers, field)
+					}
+				}
+				unstructured.SetNestedMap(obj.Object, rawSpec, specPath...)
+			}
+
+			var podSpec corev1.PodSpec
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(rawSpec, &podSpec); err != nil {
+				warnings = append(warnings, fmt.Sprintf("could not inspect pod spec references: %v", err))
+			} else {
+				dependencies = append(dependencies, podSpecDependencies(&podSpec)...)
+			}
+		}
+	}
+
+	if rewrites == nil {
+		rewrites = []string{}
+	}
+	if warnings == nil {
+		warnings = []string{}
+	}
+	entry["rewrites"] = rewrites
+	entry["dependencies"] = dependencies
+	entry["warnings"] = warnings
+	return entry
+}
+
+// podSpecPath returns where the pod spec lives inside an object of the given kind, or nil for kinds without one
+func podSpecPath(kind string) []string {
+	switch kind {
+	case "Pod":
+		return []string{"spec"}
+	case "Deployment", "StatefulSet", "DaemonSet", "ReplicaSet", "Job":
+		return []string{"spec", "template", "spec"}
+	case "CronJob":
+		return []string{"spec", "jobTemplate", "spec", "template", "spec"}
+	}
+	return nil
+}
+
+// podSpecDependencies lists the same-namespace objects a pod spec needs to exist before it can run
+func podSpecDependencies(spec *corev1.PodSpec) []map[string]interface{} {
+	configMaps := map[string]bool{}
+	secrets := map[string]bool{}
+	collectPodSpecReferences(spec, configMaps, secrets)
+
+	claims := map[string]bool{}
+	for _, volume := range spec.Volumes {
+		if volume.PersistentVolumeClaim != nil {
+			claims[volume.PersistentVolumeClaim.ClaimName] = true
+		}
+	}
+
+	var dependencies []map[string]interface{}
+	dependencies = appendDependencies(dependencies, "ConfigMap", configMaps)
+	dependencies = appendDependencies(dependencies, "Secret", secrets)
+	dependencies = appendDependencies(dependencies, "PersistentVolumeClaim", claims)
+
+	if spec.ServiceAccountName != "" && spec.ServiceAccountName != "default" {
+		dependencies = append(dependencies, map[string]interface{}{"kind": "ServiceAccount", "name": spec.ServiceAccountName, "reference": "serviceAccountName"})
+	}
+
+	return dependencies
+}
+
+// appendDependencies adds one pod spec dependency entry per name, in sorted order
+func appendDependencies(dependencies []map[string]interface{}, kind string, names map[string]bool) []map[string]interface{} {
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		if name != "" {
+			sorted = append(sorted, name)
+		}
+	}
+	sort.Strings(sorted)
+	for _, name := range sorted {
+		dependencies = append(dependencies, map[string]interface{}{"kind": kind, "name": name, "reference": "pod spec"})
+	}
+	return dependencies
+}
+
+// rebaseServiceDNS rewrites '<service>.<source>.svc' style hostnames to point at the target namespace
+func rebaseServiceDNS(value, sourceNamespace, targetNamespace string) (string, bool) {
+	needle := "." + sourceNamespace + ".svc"
+	if !strings.Contains(value, needle) {
+		return value, false
+	}
+	return strings.ReplaceAll(value, needle, "."+targetNamespace+".svc"), true
+}
+
+// namespacedObjectExists reports whether a namespaced object of a supported kind exists
+func (c *Client) namespacedObjectExists(ctx context.Context, kind, name, namespace string) (bool, error) {
+	rk, err := resolveKind(kind)
+	if err != nil {
+		return false, err
+	}
+	_, err = c.resourceInterface(rk, namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// GetRecentlyModified lists resources of a kind ordered by their most recent change signal
+func (c *Client) GetRecentlyModified(ctx context.Context, kind, namespace string, since time.Duration, limit int) (map[string]interface{}, error) {
+	rk, err := resolveKind(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	if rk.Namespaced && namespace == "" {
+		namespace = "default"
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+
+	list, err := c.resourceInterface(rk, namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s: %v", rk.Resource.Resource, err)
+	}
+
+	// Index the latest event per object; failing to read events only weakens the signal
+	type eventInfo struct {
+		time    time.Time
+		reason  string
+		message string
+		count   int
+	}
+	latestEvents := map[string]*eventInfo{}
+	eventNamespace := namespace
+	if !rk.Namespaced {
+		eventNamespace = ""
+	}
+	events, eventsErr := c.clientset.CoreV1().Events(eventNamespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("involvedObject.kind=%s", rk.Kind),
+	})
+	if eventsErr == nil {
+		for _, event := range events.Items {
+			eventTime := eventTimestamp(&event)
+			key := event.InvolvedObject.Name
+			info, exists := latestEvents[key]
+			if !exists {
+				info = &eventInfo{}
+				latestEvents[key] = info
+			}
+			info.count++
+			if eventTime.After(info.time) {
+				info.time = eventTime
+				info.reason = event.Reason
+				info.message = event.Message
+			}
+		}
+	}
+
+	cutoff := time.Time{}
+	if since > 0 {
+		cutoff = time.Now().Add(-since)
+	}
+
+	type change struct {
+		at    time.Time
+		entry map[string]interface{}
+	}
+	var changes []change
+
+	// Kubernetes keeps no modification timestamp, so approximate it with the newest of
+	// creationTimestamp, managedFields write times (status updates excluded) and the last event
+	for _, obj := range list.Items {
+		lastChange := obj.GetCreationTimestamp().Time
+		signal := "created"
+		changedBy := ""
+		operation := "Create"
+
+		for _, managed := range obj.GetManagedFields() {
+			if managed.Time == nil || managed.Subresource == "status" {
+				continue
+			}
+			if managed.Time.Time.After(lastChange) {
+				lastChange = managed.Time.Time
+				signal = "managedFields"
+				changedBy = managed.Manager
+				operation = string(managed.Operation)
+			}
+		}
+
+		entry := map[string]interface{}{
+			"name":              obj.GetName(),
+			"creationTimestamp": obj.GetCreationTimestamp().Time.Format(time.RFC3339),
+			"generation":        obj.GetGeneration(),
+		}
+		if obj.GetNamespace() != "" {
+			entry["namespace"] = obj.GetNamespace()
+		}
+		if changedBy != "" {
+			entry["changedBy"] = changedBy
+			entry["operation"] = operation
+		}
+
+		if observed, found, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration"); found {
+			entry["observedGeneration"] = observed
+			entry["pendingReconcile"] = obj.GetGeneration() > observed
+		}
+
+		if info, exists := latestEvents[obj.GetName()]; exists {
+			entry["lastEvent"] = map[string]interface{}{
+				"reason":  info.reason,
+				"message": info.message,
+				"time":    info.time.Format(time.RFC3339),
+			}
+			entry["eventCount"] = info.count
+			if info.time.After(lastChange) {
+				lastChange = info.time
+				signal = "event"
+			}
+		}
+
+		if !cutoff.IsZero() && lastChange.Before(cutoff) {
+			// Unreconciled spec changes are always recent enough to report
+			if pending, _ := entry["pendingReconcile"].(bool); !pending {
+				continue
+			}
+		}
+
+		entry["lastChangeTime"] = lastChange.Format(time.RFC3339)
+		entry["lastChangeAge"] = time.Since(lastChange).Round(time.Second).String()
+		entry["signal"] = signal
+		changes = append(changes, change{at: lastChange, entry: entry})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].at.After(changes[j].at)
+	})
+
+	total := len(changes)
+	if len(changes) > limit {
+		changes = changes[:limit]
+	}
+
+	resources := make([]map[string]interface{}, 0, len(changes))
+	for _, ch := range changes {
+		resources = append(resources, ch.entry)
+	}
+
+	scope := "cluster"
+	if rk.Namespaced {
+		scope = namespace
+	}
+
+	result := map[string]interface{}{
+		"kind":          rk.Kind,
+		"scope":         scope,
+		"resources":     resources,
+		"count":         len(resources),
+		"matchingCount": total,
+		"approximation": "Change time is the newest of creationTimestamp, managedFields write times (excluding status updates) and the latest event for the object; Kubernetes does not record a true modification time",
+	}
+	if since > 0 {
+		result["since"] = since.String()
+	}
+	if eventsErr != nil {
+		result["eventsError"] = fmt.Sprintf("events could not be read, event signal skipped: %v", eventsErr)
+	}
+
+	return result, nil
+}
+
+// ========== RBAC OPERATIONS ==========
+
+// defaultAccessResources are the resource types summarized when no explicit list is requested
+var defaultAccessResources = []string{"pods", "deployments", "services", "configmaps", "secrets", "persistentvolumeclaims", "jobs", "ingresses", "roles", "rolebindings"}
+
+// accessVerbs are the standard verbs shown in the access matrix
+var accessVerbs = []string{"get", "list", "watch", "create", "update", "patch", "delete"}
+
+// namespaceAccessEntry accumulates what one subject may do in the namespace
+type namespaceAccessEntry struct {
+	kind      string
+	name      string
+	namespace string
+	grantedBy []string
+	verbs     map[string]map[string]bool
+	// Grants limited to specific objects through rules with resourceNames, kept per rule
+	restricted map[string]map[string]restrictedGrant
+}
+
+// restrictedGrant is the access one resourceNames-limited rule gives
+type restrictedGrant struct {
+	verbs []string
+	names []string
+}
+
+// broadAccessGroups are the groups every (or every anonymous) request belongs to; they are always shown
+// because a binding to them opens the namespace to all tenants
+var broadAccessGroups = map[string]bool{
+	"system:authenticated":   true,
+	"system:unauthenticated": true,
+}
+
+// GetNamespaceAccess builds a subject-by-resource access matrix from the RoleBindings in a namespace and all ClusterRoleBindings
+func (c *Client) GetNamespaceAccess(ctx context.Context, namespace string, resources []string, includeSystem bool) (map[string]interface{}, error) {
+	if namespace == "" {
+		namespace = "default"
+	}
+	if len(resources) == 0 {
+		resources = defaultAccessResources
+	}
+
+	targets := make([]schema.GroupResource, 0, len(resources))
+	for _, r := range resources {
+		rk, err := resolveKind(r)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, rk.Resource.GroupResource())
+	}
+
+	roleBindings, err := c.clientset.RbacV1().RoleBindings(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rolebindings in namespace '%s': %v", namespace, err)
+	}
+	clusterRoleBindings, err := c.clientset.RbacV1().ClusterRoleBindings().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusterrolebindings: %v", err)
+	}
+
+	entries := map[string]*namespaceAccessEntry{}
+	var unresolved []string
+
+	grant := func(bindingRef string, systemBinding bool, subjects []rbacv1.Subject, rules []rbacv1.PolicyRule) {
+		for _, subject := range subjects {
+			broadGroup := subject.Kind == rbacv1.GroupKind && broadAccessGroups[subject.Name]
+			if !includeSystem && !broadGroup && (systemBinding || strings.HasPrefix(subject.Name, "system:")) {
+				continue
+			}
+
+			key := subject.Kind + "/" + subject.Namespace + "/" + subject.Name
+			entry, exists := entries[key]
+			if !exists {
+				entry = &namespaceAccessEntry{
+					kind:       subject.Kind,
+					name:       subject.Name,
+					namespace:  subject.Namespace,
+					verbs:      map[string]map[string]bool{},
+					restricted: map[string]map[string]restrictedGrant{},
+				}
+				entries[key] = entry
+			}
+			entry.grantedBy = append(entry.grantedBy, bindingRef)
+
+			for _, target := range targets {
+				for _, verb := range rulesVerbsFor(rules, target) {
+					if entry.verbs[target.Resource] == nil {
+						entry.verbs[target.Resource] = map[string]bool{}
+					}
+					entry.verbs[target.Resource][verb] = true
+				}
+
+				for _, restricted := range restrictedRuleGrants(rules, target) {
+					if entry.restricted[target.Resource] == nil {
+						entry.restricted[target.Resource] = map[string]restrictedGrant{}
+					}
+					// The same rule reached through several bindings is only listed once
+					entry.restricted[target.Resource][restricted.String()] = restricted
+				}
+			}
+		}
+	}
+
+	for _, binding := range roleBindings.Items {
+		bindingRef := fmt.Sprintf("RoleBinding/%s -> %s/%s", binding.Name, binding.RoleRef.Kind, binding.RoleRef.Name)
+		rules, err := c.resolveRoleRules(ctx, namespace, binding.RoleRef)
+		if err != nil {
+			unresolved = append(unresolved, fmt.Sprintf("%s: %v", bindingRef, err))
+			continue
+		}
+		grant(bindingRef, false, binding.Subjects, rules)
+	}
+
+	for _, binding := range clusterRoleBindings.Items {
+		bindingRef := fmt.Sprintf("ClusterRoleBinding/%s -> ClusterRole/%s", binding.Name, binding.RoleRef.Name)
+		rules, err := c.resolveRoleRules(ctx, namespace, binding.RoleRef)
+		if err != nil {
+			unresolved = append(unresolved, fmt.Sprintf("%s: %v", bindingRef, err))
+			continue
+		}
+		grant(bindingRef, strings.HasPrefix(binding.Name, "system:"), binding.Subjects, rules)
+	}
+
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	resourceNames := make([]string, 0, len(targets))
+	for _, target := range targets {
+		resourceNames = append(resourceNames, target.Resource)
+	}
+
+	subjects := []map[string]interface{}{}
+	table := []string{"SUBJECT | " + strings.Join(resourceNames, " | ")}
+	withoutAccess := 0
+	for _, key := range keys {
+		entry := entries[key]
+
+		// Bindings such as cluster-wide ones often grant nothing on the requested resources
+		if len(entry.verbs) == 0 && len(entry.restricted) == 0 {
+			withoutAccess++
+			continue
+		}
+
+		subjectName := entry.name
+		if entry.kind == rbacv1.ServiceAccountKind {
+			subjectName = entry.namespace + "/" + entry.name
+		}
+
+		access := map[string]interface{}{}
+		restricted := map[string]interface{}{}
+		row := []string{fmt.Sprintf("%s:%s", entry.kind, subjectName)}
+		for _, resourceName := range resourceNames {
+			summary := summarizeVerbs(entry.verbs[resourceName])
+			if grants := entry.restricted[resourceName]; len(grants) > 0 {
+				grantKeys := make([]string, 0, len(grants))
+				for grantKey := range grants {
+					grantKeys = append(grantKeys, grantKey)
+				}
+				sort.Strings(grantKeys)
+
+				grantList := []map[string]interface{}{}
+				for _, grantKey := range grantKeys {
+					if summary == "-" {
+						summary = grantKey
+					} else {
+						summary += "; " + grantKey
+					}
+					grantList = append(grantList, map[string]interface{}{
+						"verbs":         grants[grantKey].verbs,
+						"resourceNames": grants[grantKey].names,
+					})
+				}
+				restricted[resourceName] = grantList
+			}
+			access[resourceName] = summary
+			row = append(row, summary)
+		}
+		table = append(table, strings.Join(row, " | "))
+
+		subjectInfo := map[string]interface{}{
+			"kind":      entry.kind,
+			"name":      entry.name,
+			"grantedBy": entry.grantedBy,
+			"access":    access,
+		}
+		if len(restricted) > 0 {
+			subjectInfo["restrictedAccess"] = restricted
+		}
+		if entry.namespace != "" {
+			subjectInfo["namespace"] = entry.namespace
+		}
+		subjects = append(subjects, subjectInfo)
+	}
+
+	result := map[string]interface{}{
+		"namespace":     namespace,
+		"resources":     resourceNames,
+		"subjects":      subjects,
+		"subjectCount":  len(subjects),
+		"matrix":        table,
+		"includeSystem": includeSystem,
+		"legend":        "'full' = all standard verbs, 'read' = get/list/watch, '-' = no access, 'verbs on [a,b]' = only on the named objects",
+	}
+	if withoutAccess > 0 {
+		result["subjectsWithoutAccess"] = withoutAccess
+	}
+	if len(unresolved) > 0 {
+		result["unresolvedBindings"] = unresolved
+	}
+
+	return result, nil
+}
+
+// resolveRoleRules returns the policy rules of the Role or ClusterRole a binding references
+func (c *Client) resolveRoleRules(ctx context.Context, namespace string, roleRef rbacv1.RoleRef) ([]rbacv1.PolicyRule, error) {
+	switch roleRef.Kind {
+	case "Role":
+		role, err := c.clientset.RbacV1().Roles(namespace).Get(ctx, roleRef.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get role '%s': %v", roleRef.Name, err)
+		}
+		return role.Rules, nil
+	case "ClusterRole":
+		clusterRole, err := c.clientset.RbacV1().ClusterRoles().Get(ctx, roleRef.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get clusterrole '%s': %v", roleRef.Name, err)
+		}
+		return clusterRole.Rules, nil
+	default:
+		return nil, fmt.Errorf("unsupported roleRef kind '%s'", roleRef.Kind)
+	}
+}
+
+// rulesVerbsFor returns the verbs granted on every object of a resource by a set of rules (resourceNames-restricted rules are excluded)
+func rulesVerbsFor(rules []rbacv1.PolicyRule, target schema.GroupResource) []string {
+	var verbs []string
+	for _, rule := range rules {
+		if len(rule.ResourceNames) > 0 {
+			continue
+		}
+		if !ruleMatches(rule.APIGroups, target.Group) || !ruleMatches(rule.Resources, target.Resource) {
+			continue
+		}
+		for _, verb := range rule.Verbs {
+			if verb == rbacv1.VerbAll {
+				return []string{rbacv1.VerbAll}
+			}
+			verbs = append(verbs, verb)
+		}
+	}
+	return verbs
+}
+
+// restrictedRuleGrants returns, rule by rule, the verbs and object names granted on a resource by rules limited with
+// resourceNames. Rules are not merged: get on a plus delete on b does not mean get and delete on both.
+func restrictedRuleGrants(rules []rbacv1.PolicyRule, target schema.GroupResource) []restrictedGrant {
+	var grants []restrictedGrant
+	for _, rule := range rules {
+		if len(rule.ResourceNames) == 0 {
+			continue
+		}
+		if !ruleMatches(rule.APIGroups, target.Group) || !ruleMatches(rule.Resources, target.Resource) {
+			continue
+		}
+		grant := restrictedGrant{
+			verbs: append([]string{}, rule.Verbs...),
+			names: append([]string{}, rule.ResourceNames...),
+		}
+		sort.Strings(grant.verbs)
+		sort.Strings(grant.names)
+		grants = append(grants, grant)
+	}
+	return grants
+}
+
+// String renders the grant as it appears in the access matrix, e.g. "get,update on [a,b]"
+func (g restrictedGrant) String() string {
+	verbs := map[string]bool{}
+	for _, verb := range g.verbs {
+		verbs[verb] = true
+	}
+	return fmt.Sprintf("%s on [%s]", summarizeVerbs(verbs), strings.Join(g.names, ","))
+}
+
+func ruleMatches(values []string, want string) bool {
+	for _, value := range values {
+		if value == want || value == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+// summarizeVerbs renders a verb set as 'full', 'read', '-' or a comma-separated list
+func summarizeVerbs(verbs map[string]bool) string {
+	if len(verbs) == 0 {
+		return "-"
+	}
+	if verbs[rbacv1.VerbAll] {
+		return "full"
+	}
+
+	var granted []string
+	for _, verb := range accessVerbs {
+		if verbs[verb] {
+			granted = append(granted, verb)
+		}
+	}
+	switch {
+	case len(granted) == len(accessVerbs):
+		return "full"
+	case len(granted) == 3 && verbs["get"] && verbs["list"] && verbs["watch"]:
+		return "read"
+	case len(granted) == 0:
+		// Only non-standard verbs such as 'escalate' or 'impersonate'
+		var other []string
+		for verb := range verbs {
+			other = append(other, verb)
+		}
+		sort.Strings(other)
+		return strings.Join(other, ",")
+	default:
+		return strings.Join(granted, ",")
+	}
+}
+
+// ========== METRICS OPERATIONS ==========
+
+// podMetrics mirrors the metrics.k8s.io/v1beta1 PodMetrics object
+type podMetrics struct {
+	Metadata   metav1.ObjectMeta `json:"metadata"`
+	Timestamp  metav1.Time       `json:"timestamp"`
+	Window     metav1.Duration   `json:"window"`
+	Containers []struct {
+		Name  string              `json:"name"`
+		Usage corev1.ResourceList `json:"usage"`
+	} `json:"containers"`
+}
+
+// nodeMetrics mirrors the metrics.k8s.io/v1beta1 NodeMetrics object
+type nodeMetrics struct {
+	Metadata  metav1.ObjectMeta   `json:"metadata"`
+	Timestamp metav1.Time         `json:"timestamp"`
+	Window    metav1.Duration     `json:"window"`
+	Usage     corev1.ResourceList `json:"usage"`
+}
+
+// listPodMetrics queries metrics-server for pod usage (empty namespace means all namespaces)
+func (c *Client) listPodMetrics(ctx context.Context, namespace string) ([]podMetrics, error) {
+	path := "/apis/metrics.k8s.io/v1beta1/pods"
+	if namespace != "" {
+		path = fmt.Sprintf("/apis/metrics.k8s.io/v1beta1/namespaces/%s/pods", namespace)
+	}
+
+	data, err := c.clientset.Discovery().RESTClient().Get().AbsPath(path).DoRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query pod metrics (is metrics-server installed?): %v", err)
+	}
+
+	var list struct {
+		Items []podMetrics `json:"items"`
+	}
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("failed to parse pod metrics: %v", err)
+	}
+
+	return list.Items, nil
+}
+
+// listNodeMetrics queries metrics-server for node usage
+func (c *Client) listNodeMetrics(ctx context.Context) ([]nodeMetrics, error) {
+	data, err := c.clientset.Discovery().RESTClient().Get().AbsPath("/apis/metrics.k8s.io/v1beta1/nodes").DoRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query node metrics (is metrics-server installed?): %v", err)
+	}
+
+	var list struct {
+		Items []nodeMetrics `json:"items"`
+	}
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("failed to parse node metrics: %v", err)
+	}
+
+	return list.Items, nil
+}
+
+// podUsage sums CPU (millicores) and memory (bytes) across all containers of a pod
+func podUsage(pm podMetrics) (int64, int64) {
+	var cpu, memory int64
+	for _, container := range pm.Containers {
+		if q, ok := container.Usage[corev1.ResourceCPU]; ok {
+			cpu += q.MilliValue()
+		}
+		if q, ok := container.Usage[corev1.ResourceMemory]; ok {
+			memory += q.Value()
+		}
+	}
+	return cpu, memory
+}
+
+// ========== METRICS HISTORY OPERATIONS ==========
+
+// metricsSample is a single point-in-time usage reading
+type metricsSample struct {
+	Timestamp     time.Time `json:"timestamp"`
+	CPUMillicores int64     `json:"cpuMillicores"`
+	MemoryBytes   int64     `json:"memoryBytes"`
+}
+
+// sampleRing is a fixed-size ring buffer of samples for one pod or node
+type sampleRing struct {
+	samples  []metricsSample
+	next     int
+	full     bool
+	lastSeen time.Time
+}
+
+func (r *sampleRing) add(sample metricsSample) {
+	r.samples[r.next] = sample
+	r.next = (r.next + 1) % len(r.samples)
+	if r.next == 0 {
+		r.full = true
+	}
+	r.lastSeen = sample.Timestamp
+}
+
+// ordered returns the buffered samples from oldest to newest
+func (r *sampleRing) ordered() []metricsSample {
+	if !r.full {
+		return append([]metricsSample(nil), r.samples[:r.next]...)
+	}
+	return append(append([]metricsSample(nil), r.samples[r.next:]...), r.samples[:r.next]...)
+}
+
+// metricsHistory keeps bounded in-memory usage series sampled from metrics-server
+type metricsHistory struct {
+	mu        sync.RWMutex
+	interval  time.Duration
+	retention time.Duration
+	capacity  int
+	series    map[string]*sampleRing
+	lastError string
+}
+
+func metricsSeriesKey(kind, namespace, name string) string {
+	if kind == "node" {
+		return "node/" + name
+	}
+	return fmt.Sprintf("pod/%s/%s", namespace, name)
+}
+
+func (h *metricsHistory) record(key string, sample metricsSample) {
+	ring, exists := h.series[key]
+	if !exists {
+		ring = &sampleRing{samples: make([]metricsSample, h.capacity)}
+		h.series[key] = ring
+	}
+	ring.add(sample)
+}
+
+// StartMetricsHistory starts a background sampler recording pod and node usage every interval, keeping the last retention window
+func (c *Client) StartMetricsHistory(ctx context.Context, interval, retention time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("metrics history interval must be positive")
+	}
+	if retention < interval {
+		return fmt.Errorf("metrics history retention (%s) must be at least the sampling interval (%s)", retention, interval)
+	}
+
+	history := &metricsHistory{
+		interval:  interval,
+		retention: retention,
+		capacity:  int(retention / interval),
+		series:    make(map[string]*sampleRing),
+	}
+	c.metricsHistory = history
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			c.sampleMetrics(ctx, history)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return nil
+}
+
+// sampleMetrics records one round of pod and node usage and drops series not seen within the retention window
+func (c *Client) sampleMetrics(ctx context.Context, history *metricsHistory) {
+	sampleCtx, cancel := context.WithTimeout(ctx, history.interval)
+	defer cancel()
+
+	pods, podErr := c.listPodMetrics(sampleCtx, "")
+	nodes, nodeErr := c.listNodeMetrics(sampleCtx)
+	now := time.Now()
+
+	history.mu.Lock()
+	defer history.mu.Unlock()
+
+	history.lastError = ""
+	if podErr != nil {
+		history.lastError = podErr.Error()
+	} else if nodeErr != nil {
+		history.lastError = nodeErr.Error()
+	}
+
+	for _, pm := range pods {
+		cpu, memory := podUsage(pm)
+		history.record(metricsSeriesKey("pod", pm.Metadata.Namespace, pm.Metadata.Name), metricsSample{
+			Timestamp:     now,
+			CPUMillicores: cpu,
+			MemoryBytes:   memory,
+		})
+	}
+
+	for _, nm := range nodes {
+		sample := metricsSample{Timestamp: now}
+		if q, ok := nm.Usage[corev1.ResourceCPU]; ok {
+			sample.CPUMillicores = q.MilliValue()
+		}
+		if q, ok := nm.Usage[corev1.ResourceMemory]; ok {
+			sample.MemoryBytes = q.Value()
+		}
+		history.record(metricsSeriesKey("node", "", nm.Metadata.Name), sample)
+	}
+
+	// Forget pods and nodes that disappeared so memory stays bounded
+	for key, ring := range history.series {
+		if now.Sub(ring.lastSeen) > history.retention {
+			delete(history.series, key)
+		}
+	}
+}
+
+// GetMetricsHistory returns the recorded usage series for a pod or node, optionally limited to the last `since` duration
+func (c *Client) GetMetricsHistory(kind, namespace, name string, since time.Duration) (map[string]interface{}, error) {
+	history := c.metricsHistory
+	if history == nil {
+		return nil, fmt.Errorf("metrics history is disabled - start the server with --metrics-history to enable sampling")
+	}
+
+	kind = strings.ToLower(kind)
+	if kind != "pod" && kind != "node" {
+		return nil, fmt.Errorf("invalid kind '%s': must be 'pod' or 'node'", kind)
+	}
+	if kind == "pod" && namespace == "" {
+		namespace = "default"
+	}
+
+	history.mu.RLock()
+	defer history.mu.RUnlock()
+
+	result := map[string]interface{}{
+		"kind":      kind,
+		"name":      name,
+		"interval":  history.interval.String(),
+		"retention": history.retention.String(),
+		"samples":   []metricsSample{},
+	}
+	if kind == "pod" {
+		result["namespace"] = namespace
+	}
+	if history.lastError != "" {
+		result["lastSamplingError"] = history.lastError
+	}
+
+	ring, exists := history.series[metricsSeriesKey(kind, namespace, name)]
+	if !exists {
+		result["message"] = fmt.Sprintf("No samples recorded yet for %s '%s'", kind, name)
+		return result, nil
+	}
+
+	samples := ring.ordered()
+	if since > 0 {
+		cutoff := time.Now().Add(-since)
+		start := sort.Search(len(samples), func(i int) bool {
+			return !samples[i].Timestamp.Before(cutoff)
+		})
+		samples = samples[start:]
+	}
+
+	if len(samples) > 0 {
+		var cpuSum, memorySum int64
+		cpuMin, cpuMax := samples[0].CPUMillicores, samples[0].CPUMillicores
+		memoryMin, memoryMax := samples[0].MemoryBytes, samples[0].MemoryBytes
+		for _, sample := range samples {
+			cpuSum += sample.CPUMillicores
+			memorySum += sample.MemoryBytes
+			if sample.CPUMillicores < cpuMin {
+				cpuMin = sample.CPUMillicores
+			}
+			if sample.CPUMillicores > cpuMax {
+				cpuMax = sample.CPUMillicores
+			}
+			if sample.MemoryBytes < memoryMin {
+				memoryMin = sample.MemoryBytes
+			}
+			if sample.MemoryBytes > memoryMax {
+				memoryMax = sample.MemoryBytes
+			}
+		}
+
+		count := int64(len(samples))
+		result["summary"] = map[string]interface{}{
+			"cpuMillicores": map[string]interface{}{
+				"min":    cpuMin,
+				"max":    cpuMax,
+				"avg":    cpuSum / count,
+				"latest": samples[len(samples)-1].CPUMillicores,
+			},
+			"memoryBytes": map[string]interface{}{
+				"min":    memoryMin,
+				"max":    memoryMax,
+				"avg":    memorySum / count,
+				"latest": samples[len(samples)-1].MemoryBytes,
+			},
+			"from": samples[0].Timestamp.Format(time.RFC3339),
+			"to":   samples[len(samples)-1].Timestamp.Format(time.RFC3339),
+		}
+	}
+
+	result["samples"] = samples
+	result["sampleCount"] = len(samples)
+	return result, nil
+}
+
+// ========== INCIDENT REPORT OPERATIONS ==========
+
+// eventTimestamp returns when an event last occurred; events.k8s.io-style events only set eventTime
+func eventTimestamp(event *corev1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	if !event.EventTime.IsZero() {
+		return event.EventTime.Time
+	}
+	return event.CreationTimestamp.Time
+}
+
+// GenerateIncidentReport bundles pod state, warning events, deployment conditions, failing-pod logs and usage for a namespace or a single deployment
+func (c *Client) GenerateIncidentReport(ctx context.Context, namespace, deploymentName string, since time.Duration, logTailLines int64, maxLogPods int) (map[string]interface{}, error) {
+	if namespace == "" {
+		namespace = "default"
+	}
+	if since <= 0 {
+		since = time.Hour
+	}
+	if logTailLines <= 0 {
+		logTailLines = 50
+	}
+	if maxLogPods <= 0 {
+		maxLogPods = 5
+	}
+
+	report := map[string]interface{}{
+		"generatedAt": time.Now().Format(time.RFC3339),
+		"namespace":   namespace,
+		"scope":       "namespace",
+		"window":      since.String(),
+	}
+
+	var deployments []appsv1.Deployment
+	labelSelector := ""
+	if deploymentName != "" {
+		deployment, err := c.clientset.AppsV1().Deployments(namespace).Get(ctx, deploymentName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get deployment '%s': %v", deploymentName, err)
+		}
+		selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector on deployment '%s': %v", deploymentName, err)
+		}
+		labelSelector = selector.String()
+		deployments = append(deployments, *deployment)
+		report["scope"] = "deployment"
+		report["deployment"] = deploymentName
+	} else {
+		deploymentList, err := c.clientset.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list deployments in namespace '%s': %v", namespace, err)
+		}
+		deployments = deploymentList.Items
+	}
+
+	var findings []string
+	var sectionErrors []string
+
+	// Pod state, with usage when metrics-server is available
+	health, err := c.GetPodsHealthStatus(ctx, namespace, labelSelector, true, "cpu")
+	if err != nil {
+		return nil, err
+	}
+	report["pods"] = health
+	// Completed pods (finished Jobs) are never ready, so they are not counted as a problem
+	if summary, ok := health["summary"].(map[string]int); ok {
+		if notReady := summary["NotReady"] - summary["Succeeded"]; notReady > 0 {
+			total, _ := health["totalPods"].(int)
+			findings = append(findings, fmt.Sprintf("%d of %d pods are not ready", notReady, total-summary["Succeeded"]))
+		}
+	}
+
+	// Deployment conditions; healthy deployments are only listed by name to keep the report focused
+	var unhealthyDeployments []map[string]interface{}
+	healthyDeployments := []string{}
+	for i := range deployments {
+		deployment := &deployments[i]
+		progress, done := rolloutProgress(deployment)
+		desired := int32(1)
+		if deployment.Spec.Replicas != nil {
+			desired = *deployment.Spec.Replicas
+		}
+		if done && deployment.Status.AvailableReplicas >= desired {
+			healthyDeployments = append(healthyDeployments, deployment.Name)
+			continue
+		}
+
+		var conditions []map[string]interface{}
+		for _, condition := range deployment.Status.Conditions {
+			conditions = append(conditions, map[string]interface{}{
+				"type":           string(condition.Type),
+				"status":         string(condition.Status),
+				"reason":         condition.Reason,
+				"message":        condition.Message,
+				"lastUpdateTime": condition.LastUpdateTime.Time.Format(time.RFC3339),
+			})
+		}
+		entry := map[string]interface{}{
+			"name":              deployment.Name,
+			"rolloutStatus":     progress,
+			"desiredReplicas":   desired,
+			"readyReplicas":     deployment.Status.ReadyReplicas,
+			"availableReplicas": deployment.Status.AvailableReplicas,
+			"updatedReplicas":   deployment.Status.UpdatedReplicas,
+			"paused":            deployment.Spec.Paused,
+			"conditions":        conditions,
+		}
+		if blockers, err := c.deploymentPodBlockers(ctx, deployment); err == nil {
+			entry["blockers"] = blockers
+		} else {
+			sectionErrors = append(sectionErrors, err.Error())
+		}
+		unhealthyDeployments = append(unhealthyDeployments, entry)
+		findings = append(findings, fmt.Sprintf("deployment '%s' has %d/%d available replicas (%s)", deployment.Name, deployment.Status.AvailableReplicas, desired, progress))
+	}
+	if unhealthyDeployments == nil {
+		unhealthyDeployments = []map[string]interface{}{}
+	}
+	report["deployments"] = map[string]interface{}{
+		"unhealthy": unhealthyDeployments,
+		"healthy":   healthyDeployments,
+	}
+
+	pods, err := c.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %v", err)
+	}
+
+	// Warning events in the window, limited to the workload's objects in deployment scope.
+	// Pods are matched through the ReplicaSets the deployment owns, so events of already replaced pods are kept.
+	ownedReplicaSets := map[string]bool{}
+	var podPrefixes []string
+	relevantPods := map[string]bool{}
+	if deploymentName != "" {
+		replicaSets, err := c.clientset.AppsV1().ReplicaSets(namespace).List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+		if err != nil {
+			sectionErrors = append(sectionErrors, fmt.Sprintf("failed to list replicasets: %v", err))
+		} else {
+			for _, rs := range replicaSets.Items {
+				if owner := metav1.GetControllerOf(&rs); owner == nil || owner.UID != deployments[0].UID {
+					continue
+				}
+				ownedReplicaSets[rs.Name] = true
+				// ReplicaSet names already carry the pod-template-hash, and their pods are named after them
+				podPrefixes = append(podPrefixes, rs.Name+"-")
+			}
+		}
+		for _, pod := range pods.Items {
+			if owner := metav1.GetControllerOf(&pod); owner != nil && owner.Kind == "ReplicaSet" && ownedReplicaSets[owner.Name] {
+				relevantPods[pod.Name] = true
+			}
+		}
+	}
+	isRelevant := func(object corev1.ObjectReference) bool {
+		switch object.Kind {
+		case "Deployment":
+			return object.Name == deploymentName
+		case "ReplicaSet":
+			return ownedReplicaSets[object.Name]
+		case "Pod":
+			if relevantPods[object.Name] {
+				return true
+			}
+			for _, prefix := range podPrefixes {
+				if strings.HasPrefix(object.Name, prefix) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	cutoff := time.Now().Add(-since)
+	events, err := c.clientset.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{FieldSelector: "type=Warning"})
+	if err != nil {
+		sectionErrors = append(sectionErrors, fmt.Sprintf("failed to list events: %v", err))
+	} else {
+		var warnings []corev1.Event
+		reasonCounts := map[string]int{}
+		for _, event := range events.Items {
+			if eventTimestamp(&event).Before(cutoff) {
+				continue
+			}
+			if deploymentName != "" && !isRelevant(event.InvolvedObject) {
+				continue
+			}
+			warnings = append(warnings, event)
+			count := int(event.Count)
+			if count == 0 {
+				count = 1
+			}
+			reasonCounts[event.Reason] += count
+		}
+
+		sort.Slice(warnings, func(i, j int) bool {
+			return eventTimestamp(&warnings[i]).After(eventTimestamp(&warnings[j]))
+		})
+		total := len(warnings)
+		if len(warnings) > 50 {
+			warnings = warnings[:50]
+		}
+
+		eventList := []map[string]interface{}{}
+		for _, event := range warnings {
+			eventList = append(eventList, map[string]interface{}{
+				"reason":        event.Reason,
+				"message":       event.Message,
+				"object":        fmt.Sprintf("%s/%s", event.InvolvedObject.Kind, event.InvolvedObject.Name),
+				"count":         event.Count,
+				"lastTimestamp": eventTimestamp(&event).Format(time.RFC3339),
+			})
+		}
+
+		reasons := make([]string, 0, len(reasonCounts))
+		for reason := range reasonCounts {
+			reasons = append(reasons, reason)
+		}
+		sort.Slice(reasons, func(i, j int) bool {
+			if reasonCounts[reasons[i]] != reasonCounts[reasons[j]] {
+				return reasonCounts[reasons[i]] > reasonCounts[reasons[j]]
+			}
+			return reasons[i] < reasons[j]
+		})
+		var topReasons []string
+		for i, reason := range reasons {
+			if i == 5 {
+				break
+			}
+			topReasons = append(topReasons, fmt.Sprintf("%s(%d)", reason, reasonCounts[reason]))
+		}
+
+		report["warningEvents"] = map[string]interface{}{
+			"total":        total,
+			"events":       eventList,
+			"reasonCounts": reasonCounts,
+		}
+		if total > 0 {
+			findings = append(findings, fmt.Sprintf("%d warning events in the last %s, top reasons: %s", total, since, strings.Join(topReasons, ", ")))
+		}
+	}
+
+	// Recent logs from the failing pods, most restarted first
+	var failing []corev1.Pod
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == corev1.PodSucceeded || pod.DeletionTimestamp != nil {
+			continue
+		}
+		if pod.Status.Phase == corev1.PodFailed || !isPodReady(&pod) {
+			failing = append(failing, pod)
+		}
+	}
+	sort.Slice(failing, func(i, j int) bool {
+		return getPodRestartCount(&failing[i]) > getPodRestartCount(&failing[j])
+	})
+
+	failingPods := []map[string]interface{}{}
+	for i, pod := range failing {
+		if i == maxLogPods {
+			break
+		}
+
+		podEntry := map[string]interface{}{
+			"name":     pod.Name,
+			"phase":    string(pod.Status.Phase),
+			"restarts": getPodRestartCount(&pod),
+		}
+		if reason, message := podWaitingReason(&pod); reason != "" {
+			podEntry["reason"] = reason
+			podEntry["message"] = message
+			findings = append(findings, fmt.Sprintf("pod '%s' is %s", pod.Name, reason))
+		}
+
+		var containerLogs []map[string]interface{}
+		statuses := append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...)
+		statuses = append(statuses, pod.Status.ContainerStatuses...)
+		for _, status := range statuses {
+			if status.Ready {
+				continue
+			}
+
+			logEntry := map[string]interface{}{
+				"container":    status.Name,
+				"restartCount": status.RestartCount,
+			}
+
+			// A crashing container's useful output is usually in the previous instance
+			previous := false
+			if terminated := status.LastTerminationState.Terminated; terminated != nil {
+				previous = status.State.Running == nil
+				logEntry["lastTermination"] = map[string]interface{}{
+					"reason":     terminated.Reason,
+					"exitCode":   terminated.ExitCode,
+					"finishedAt": terminated.FinishedAt.Time.Format(time.RFC3339),
+				}
+			}
+			logEntry["previous"] = previous
+
+			if status.State.Waiting != nil && status.LastTerminationState.Terminated == nil {
+				logEntry["logs"] = ""
+				logEntry["logsNote"] = "container has not started yet"
+			} else {
+				logs, err := c.GetPodLogs(ctx, namespace, pod.Name, status.Name, logTailLines, false, previous)
+				if err != nil {
+					logEntry["logsError"] = err.Error()
+				} else {
+					logEntry["logs"] = logs
+				}
+			}
+			containerLogs = append(containerLogs, logEntry)
+		}
+		podEntry["containers"] = containerLogs
+		failingPods = append(failingPods, podEntry)
+	}
+	report["failingPods"] = map[string]interface{}{
+		"total":    len(failing),
+		"included": len(failingPods),
+		"pods":     failingPods,
+	}
+
+	if findings == nil {
+		findings = []string{"no failing pods, unhealthy deployments or warning events found in the window"}
+	}
+	report["findings"] = findings
+	if len(sectionErrors) > 0 {
+		report["errors"] = sectionErrors
+	}
+
+	return report, nil
 }
@@ -0,0 +1,162 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newTestDeployment(namespace, name string, replicas int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": name}},
+		},
+	}
+}
+
+func TestScaleAllDeploymentsScalesEachDeployment(t *testing.T) {
+	const namespace = "team-a"
+	const count = 25
+	const target = int32(3)
+
+	var objects []runtime.Object
+	for i := 0; i < count; i++ {
+		// Distinct starting replica counts expose results that were computed from the wrong (aliased) item
+		objects = append(objects, newTestDeployment(namespace, fmt.Sprintf("app-%02d", i), int32(i+10)))
+	}
+	client := &Client{clientset: fake.NewSimpleClientset(objects...)}
+
+	result, err := client.ScaleAllDeployments(context.Background(), namespace, target, "", false, 5)
+	if err != nil {
+		t.Fatalf("ScaleAllDeployments returned error: %v", err)
+	}
+
+	entries, ok := result["deployments"].([]map[string]interface{})
+	if !ok {
+		t.Fatalf("deployments has type %T, want []map[string]interface{}", result["deployments"])
+	}
+	if len(entries) != count {
+		t.Fatalf("got %d result entries, want %d", len(entries), count)
+	}
+	if result["successful"] != count || result["failed"] != 0 {
+		t.Fatalf("got successful=%v failed=%v, want %d and 0", result["successful"], result["failed"], count)
+	}
+
+	seen := map[string]bool{}
+	for i, entry := range entries {
+		name := fmt.Sprintf("app-%02d", i)
+		if entry["name"] != name {
+			t.Errorf("entry %d has name %v, want %s", i, entry["name"], name)
+		}
+		if seen[name] {
+			t.Errorf("deployment %s appears more than once in the results", name)
+		}
+		seen[name] = true
+		if entry["status"] != "scaled" {
+			t.Errorf("entry %s has status %v (error %v), want scaled", name, entry["status"], entry["error"])
+		}
+		if entry["currentReplicas"] != int32(i+10) {
+			t.Errorf("entry %s reports currentReplicas %v, want %d", name, entry["currentReplicas"], i+10)
+		}
+
+		deployment, err := client.clientset.AppsV1().Deployments(namespace).Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("failed to get deployment %s: %v", name, err)
+		}
+		if deployment.Spec.Replicas == nil || *deployment.Spec.Replicas != target {
+			t.Errorf("deployment %s has spec.replicas %v, want %d", name, deployment.Spec.Replicas, target)
+		}
+	}
+}
+
+func TestScaleAllDeploymentsDryRunLeavesReplicas(t *testing.T) {
+	const namespace = "team-a"
+
+	client := &Client{clientset: fake.NewSimpleClientset(
+		newTestDeployment(namespace, "web", 2),
+		newTestDeployment(namespace, "worker", 4),
+	)}
+
+	if _, err := client.ScaleAllDeployments(context.Background(), namespace, 8, "", true, 2); err != nil {
+		t.Fatalf("ScaleAllDeployments returned error: %v", err)
+	}
+
+	for name, want := range map[string]int32{"web": 2, "worker": 4} {
+		deployment, err := client.clientset.AppsV1().Deployments(namespace).Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatalf("failed to get deployment %s: %v", name, err)
+		}
+		if *deployment.Spec.Replicas != want {
+			t.Errorf("dry run changed deployment %s to %d replicas, want %d", name, *deployment.Spec.Replicas, want)
+		}
+	}
+}
+
+func newTestDynamicClient(objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	return dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), objects...)
+}
+
+func TestMergeFieldsReportsDottedKeys(t *testing.T) {
+	configMap := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":        "settings",
+			"namespace":   "team-a",
+			"annotations": map[string]interface{}{"app.kubernetes.io/name": "old"},
+		},
+		"data": map[string]interface{}{"app.properties": "a=1"},
+	}}
+	client := &Client{dynamicClient: newTestDynamicClient(configMap)}
+
+	fields := `{"metadata":{"annotations":{"app.kubernetes.io/name":"new"}},"data":{"app.properties":"a=2"}}`
+	result, err := client.MergeFields(context.Background(), "ConfigMap", "settings", "team-a", fields, false)
+	if err != nil {
+		t.Fatalf("MergeFields returned error: %v", err)
+	}
+
+	if result["changedCount"] != 2 {
+		t.Fatalf("got changedCount %v (%v), want 2", result["changedCount"], result["changedFields"])
+	}
+	changed := result["changedFields"].([]map[string]interface{})
+	want := map[string][2]string{
+		"data.app.properties":                         {"a=1", "a=2"},
+		"metadata.annotations.app.kubernetes.io/name": {"old", "new"},
+	}
+	for _, entry := range changed {
+		values, ok := want[entry["path"].(string)]
+		if !ok {
+			t.Errorf("unexpected changed path %v", entry["path"])
+			continue
+		}
+		if entry["oldValue"] != values[0] || entry["newValue"] != values[1] {
+			t.Errorf("path %v changed %v -> %v, want %s -> %s", entry["path"], entry["oldValue"], entry["newValue"], values[0], values[1])
+		}
+	}
+}
+
+func TestMergeFieldsAllowsUnchangedReplicasAboveLimit(t *testing.T) {
+	deployment := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "web", "namespace": "team-a"},
+		"spec":       map[string]interface{}{"replicas": int64(150)},
+	}}
+	client := &Client{dynamicClient: newTestDynamicClient(deployment), maxReplicas: 100}
+
+	if _, err := client.MergeFields(context.Background(), "Deployment", "web", "team-a", `{"spec":{"replicas":150,"paused":true}}`, false); err != nil {
+		t.Fatalf("MergeFields rejected an unchanged replica count: %v", err)
+	}
+	if _, err := client.MergeFields(context.Background(), "Deployment", "web", "team-a", `{"spec":{"replicas":151}}`, false); err == nil {
+		t.Fatalf("MergeFields accepted a replica change above the limit")
+	}
+}
@@ -1,16 +1,22 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
 	"os"
 	"os/signal"
+	"path"
+	"strconv"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/hendzormati/simple-k8s-mcp-server/handlers"
 	"github.com/hendzormati/simple-k8s-mcp-server/pkg/k8s"
 	"github.com/hendzormati/simple-k8s-mcp-server/tools"
+	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 )
 
@@ -22,6 +28,86 @@ func getEnvOrDefault(key, defaultValue string) string {
 	return defaultValue
 }
 
+// getEnvDurationOrDefault returns the environment variable parsed as a duration or the default value if not set or invalid
+func getEnvDurationOrDefault(key string, defaultValue time.Duration) time.Duration {
+	if value, exists := os.LookupEnv(key); exists {
+		if duration, err := time.ParseDuration(value); err == nil {
+			return duration
+		}
+		log.Printf("⚠️  Warning: Invalid duration %q for %s, using default %s", value, key, defaultValue)
+	}
+	return defaultValue
+}
+
+// getEnvIntOrDefault returns the environment variable parsed as an int or the default value if not set or invalid
+func getEnvIntOrDefault(key string, defaultValue int) int {
+	if value, exists := os.LookupEnv(key); exists {
+		if parsed, err := strconv.Atoi(value); err == nil {
+			return parsed
+		}
+		log.Printf("⚠️  Warning: Invalid integer %q for %s, using default %d", value, key, defaultValue)
+	}
+	return defaultValue
+}
+
+// getEnvBoolOrDefault returns the environment variable parsed as a bool or the default value if not set or invalid
+func getEnvBoolOrDefault(key string, defaultValue bool) bool {
+	if value, exists := os.LookupEnv(key); exists {
+		if parsed, err := strconv.ParseBool(value); err == nil {
+			return parsed
+		}
+		log.Printf("⚠️  Warning: Invalid boolean %q for %s, using default %t", value, key, defaultValue)
+	}
+	return defaultValue
+}
+
+// parseToolList splits a comma-separated list of tool names or glob patterns, dropping empty entries
+func parseToolList(value string) []string {
+	var names []string
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// matchesToolPattern reports whether a tool name matches any entry (exact name or glob such as 'delete*')
+func matchesToolPattern(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// describeToolList formats a tool list for the startup banner
+func describeToolList(names []string, empty string) string {
+	if len(names) == 0 {
+		return empty
+	}
+	return strings.Join(names, ", ")
+}
+
+// toolPolicyMiddleware rejects calls to tools blocked by --deny-tools or missing from a non-empty --allow-tools
+func toolPolicyMiddleware(allow, deny []string) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			name := request.Params.Name
+			if matchesToolPattern(deny, name) {
+				log.Printf("🛡️  Blocked call to '%s' (matched --deny-tools)", name)
+				return nil, fmt.Errorf("tool '%s' is blocked by server policy (deny-tools)", name)
+			}
+			if len(allow) > 0 && !matchesToolPattern(allow, name) {
+				log.Printf("🛡️  Blocked call to '%s' (not in --allow-tools)", name)
+				return nil, fmt.Errorf("tool '%s' is blocked by server policy (not in allow-tools)", name)
+			}
+			return next(ctx, request)
+		}
+	}
+}
+
 func main() {
 	fmt.Println("🚀 Starting Simple K8s MCP Server...")
 
@@ -29,10 +115,24 @@ func main() {
 	var mode string
 	var port string
 	var host string
+	var overviewCacheTTL time.Duration
+	var metricsHistory bool
+	var metricsHistoryInterval time.Duration
+	var metricsHistoryRetention time.Duration
+	var maxReplicas int
+	var allowTools string
+	var denyTools string
 
 	flag.StringVar(&port, "port", getEnvOrDefault("SERVER_PORT", "8080"), "Server port")
 	flag.StringVar(&host, "host", getEnvOrDefault("SERVER_HOST", "localhost"), "Server host address")
 	flag.StringVar(&mode, "mode", getEnvOrDefault("SERVER_MODE", "stdio"), "Server mode: 'stdio' or 'sse'")
+	flag.DurationVar(&overviewCacheTTL, "overview-cache-ttl", getEnvDurationOrDefault("OVERVIEW_CACHE_TTL", 30*time.Second), "How long getClusterOverview results are cached (0 disables caching)")
+	flag.BoolVar(&metricsHistory, "metrics-history", getEnvBoolOrDefault("METRICS_HISTORY", false), "Periodically sample pod/node metrics into memory for getMetricsHistory")
+	flag.DurationVar(&metricsHistoryInterval, "metrics-history-interval", getEnvDurationOrDefault("METRICS_HISTORY_INTERVAL", 30*time.Second), "How often the metrics history sampler runs")
+	flag.DurationVar(&metricsHistoryRetention, "metrics-history-retention", getEnvDurationOrDefault("METRICS_HISTORY_RETENTION", 30*time.Minute), "How much metrics history is kept in memory")
+	flag.IntVar(&maxReplicas, "max-replicas", getEnvIntOrDefault("MAX_REPLICAS", 100), "Reject scale requests above this many replicas (0 disables the limit)")
+	flag.StringVar(&allowTools, "allow-tools", getEnvOrDefault("ALLOW_TOOLS", ""), "Comma-separated tool names or globs that may be called (empty allows all registered tools)")
+	flag.StringVar(&denyTools, "deny-tools", getEnvOrDefault("DENY_TOOLS", ""), "Comma-separated tool names or globs that are rejected at call time (takes precedence over --allow-tools)")
 	flag.Parse()
 
 	// Initialize Kubernetes client (with graceful error handling)
@@ -49,13 +149,40 @@ func main() {
 		} else {
 			fmt.Println("✅ Successfully connected to Kubernetes cluster!")
 		}
+		k8sClient.SetOverviewCacheTTL(overviewCacheTTL)
+		k8sClient.SetMaxReplicas(int32(maxReplicas))
+
+		if metricsHistory {
+			if err := k8sClient.StartMetricsHistory(context.Background(), metricsHistoryInterval, metricsHistoryRetention); err != nil {
+				log.Printf("⚠️  Warning: Metrics history disabled: %v", err)
+			} else {
+				fmt.Printf("📈 Metrics history enabled (every %s, keeping %s)\n", metricsHistoryInterval, metricsHistoryRetention)
+			}
+		}
+	}
+
+	// Tool call policy
+	allowList := parseToolList(allowTools)
+	denyList := parseToolList(denyTools)
+	for _, pattern := range append(append([]string{}, allowList...), denyList...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			log.Fatalf("❌ Invalid tool pattern %q: %v", pattern, err)
+		}
+	}
+
+	serverOptions := []server.ServerOption{
+		server.WithResourceCapabilities(true, true), // Enable resource listing and subscription capabilities
+	}
+	if len(allowList) > 0 || len(denyList) > 0 {
+		serverOptions = append(serverOptions, server.WithToolHandlerMiddleware(toolPolicyMiddleware(allowList, denyList)))
+		fmt.Printf("🛡️  Tool policy enabled (allow: %s, deny: %s)\n", describeToolList(allowList, "all"), describeToolList(denyList, "none"))
 	}
 
 	// Create MCP server
 	mcpServer := server.NewMCPServer(
 		"Simple K8s MCP Server",
 		"1.0.0",
-		server.WithResourceCapabilities(true, true), // Enable resource listing and subscription capabilities
+		serverOptions...,
 	)
 
 	// Register all tools
@@ -116,14 +243,18 @@ func registerAllTools(mcpServer *server.MCPServer, k8sClient *k8s.Client) {
 	mcpServer.AddTool(tools.GetPodMetricsTool(), handlers.GetPodMetrics(k8sClient))
 	mcpServer.AddTool(tools.DescribePodTool(), handlers.DescribePod(k8sClient))
 	mcpServer.AddTool(tools.DeletePodTool(), handlers.DeletePod(k8sClient))
+	mcpServer.AddTool(tools.EvictPodTool(), handlers.EvictPod(k8sClient))
 	mcpServer.AddTool(tools.GetPodEventsTool(), handlers.GetPodEvents(k8sClient))
 	mcpServer.AddTool(tools.RestartPodTool(), handlers.RestartPod(k8sClient))
 	mcpServer.AddTool(tools.CreatePodTool(), handlers.CreatePod(k8sClient))
 	mcpServer.AddTool(tools.UpdatePodTool(), handlers.UpdatePod(k8sClient))
+	mcpServer.AddTool(tools.WaitForPodConditionTool(), handlers.WaitForPodCondition(k8sClient))
 
 	// Extended Pod tools
 	mcpServer.AddTool(tools.GetPodResourceUsageTool(), handlers.GetPodResourceUsage(k8sClient))
 	mcpServer.AddTool(tools.GetPodsHealthStatusTool(), handlers.GetPodsHealthStatus(k8sClient))
+	mcpServer.AddTool(tools.GetResourceRatiosTool(), handlers.GetResourceRatios(k8sClient))
+	mcpServer.AddTool(tools.GetContainerRestartsTool(), handlers.GetContainerRestarts(k8sClient))
 
 	// Core Namespace tools
 	mcpServer.AddTool(tools.ListNamespacesTool(), handlers.ListNamespaces(k8sClient))
@@ -136,14 +267,17 @@ func registerAllTools(mcpServer *server.MCPServer, k8sClient *k8s.Client) {
 	mcpServer.AddTool(tools.GetNamespaceResourceQuotaTool(), handlers.GetNamespaceResourceQuota(k8sClient))
 	mcpServer.AddTool(tools.GetNamespaceEventsTool(), handlers.GetNamespaceEvents(k8sClient))
 	mcpServer.AddTool(tools.GetNamespaceAllResourcesTool(), handlers.GetNamespaceAllResources(k8sClient))
+	mcpServer.AddTool(tools.PreviewNamespaceDeletionTool(), handlers.PreviewNamespaceDeletion(k8sClient))
 	mcpServer.AddTool(tools.GetNamespaceYAMLTool(), handlers.GetNamespaceYAML(k8sClient))
 	mcpServer.AddTool(tools.SetNamespaceResourceQuotaTool(), handlers.SetNamespaceResourceQuota(k8sClient))
 	mcpServer.AddTool(tools.GetNamespaceLimitRangesTool(), handlers.GetNamespaceLimitRanges(k8sClient))
 	mcpServer.AddTool(tools.SetNamespaceLimitRangeTool(), handlers.SetNamespaceLimitRange(k8sClient))
+	mcpServer.AddTool(tools.CleanupNamespaceTool(), handlers.CleanupNamespace(k8sClient))
 
 	// Extended Namespace tools
 	mcpServer.AddTool(tools.GetNamespaceResourceUsageTool(), handlers.GetNamespaceResourceUsage(k8sClient))
 	mcpServer.AddTool(tools.GetClusterOverviewTool(), handlers.GetClusterOverview(k8sClient))
+	mcpServer.AddTool(tools.GetControlPlaneHealthTool(), handlers.GetControlPlaneHealth(k8sClient))
 
 	// Core Deployment tools
 	mcpServer.AddTool(tools.ListDeploymentsTool(), handlers.ListDeployments(k8sClient))
@@ -162,15 +296,19 @@ func registerAllTools(mcpServer *server.MCPServer, k8sClient *k8s.Client) {
 	mcpServer.AddTool(tools.GetDeploymentEventsTool(), handlers.GetDeploymentEvents(k8sClient))
 	mcpServer.AddTool(tools.GetDeploymentLogsTool(), handlers.GetDeploymentLogs(k8sClient))
 	mcpServer.AddTool(tools.RestartDeploymentTool(), handlers.RestartDeployment(k8sClient))
+	mcpServer.AddTool(tools.TriggerReloadByLabelTool(), handlers.TriggerReloadByLabel(k8sClient))
 	mcpServer.AddTool(tools.WaitForDeploymentTool(), handlers.WaitForDeployment(k8sClient))
+	mcpServer.AddTool(tools.WatchRolloutTool(), handlers.WatchRollout(k8sClient))
 	mcpServer.AddTool(tools.SetDeploymentImageTool(), handlers.SetDeploymentImage(k8sClient))
 	mcpServer.AddTool(tools.SetDeploymentEnvTool(), handlers.SetDeploymentEnv(k8sClient))
 	mcpServer.AddTool(tools.PatchDeploymentTool(), handlers.PatchDeployment(k8sClient))
 	mcpServer.AddTool(tools.GetDeploymentYAMLTool(), handlers.GetDeploymentYAML(k8sClient))
 	mcpServer.AddTool(tools.SetDeploymentResourcesTool(), handlers.SetDeploymentResources(k8sClient))
+	mcpServer.AddTool(tools.SetRolloutTimingTool(), handlers.SetRolloutTiming(k8sClient))
 	mcpServer.AddTool(tools.GetDeploymentMetricsTool(), handlers.GetDeploymentMetrics(k8sClient))
 	mcpServer.AddTool(tools.ListAllDeploymentsTool(), handlers.ListAllDeployments(k8sClient))
 	mcpServer.AddTool(tools.ScaleAllDeploymentsTool(), handlers.ScaleAllDeployments(k8sClient))
+	mcpServer.AddTool(tools.ReconcileReplicasTool(), handlers.ReconcileReplicas(k8sClient))
 
 	// Core Service tools
 	mcpServer.AddTool(tools.ListServicesTool(), handlers.ListServices(k8sClient))
@@ -190,6 +328,31 @@ func registerAllTools(mcpServer *server.MCPServer, k8sClient *k8s.Client) {
 	mcpServer.AddTool(tools.GetServiceMetricsTool(), handlers.GetServiceMetrics(k8sClient))
 	mcpServer.AddTool(tools.GetServiceTopologyTool(), handlers.GetServiceTopology(k8sClient))
 	mcpServer.AddTool(tools.CreateServiceFromPodsTool(), handlers.CreateServiceFromPods(k8sClient))
+	mcpServer.AddTool(tools.TraceServicePathTool(), handlers.TraceServicePath(k8sClient))
+	mcpServer.AddTool(tools.CheckPortConflictsTool(), handlers.CheckPortConflicts(k8sClient))
+
+	// Admission control tools
+	mcpServer.AddTool(tools.ListWebhooksTool(), handlers.ListWebhooks(k8sClient))
+
+	// CronJob tools
+	mcpServer.AddTool(tools.ListCronJobsTool(), handlers.ListCronJobs(k8sClient))
+	mcpServer.AddTool(tools.TriggerCronJobTool(), handlers.TriggerCronJob(k8sClient))
+
+	// Generic resource tools
+	mcpServer.AddTool(tools.ExportKindTool(), handlers.ExportKind(k8sClient))
+	mcpServer.AddTool(tools.DriftFromLastAppliedTool(), handlers.DriftFromLastApplied(k8sClient))
+	mcpServer.AddTool(tools.MergeFieldsTool(), handlers.MergeFields(k8sClient))
+	mcpServer.AddTool(tools.RebaseManifestTool(), handlers.RebaseManifest(k8sClient))
+	mcpServer.AddTool(tools.GetRecentlyModifiedTool(), handlers.GetRecentlyModified(k8sClient))
+
+	// RBAC tools
+	mcpServer.AddTool(tools.GetNamespaceAccessTool(), handlers.GetNamespaceAccess(k8sClient))
+
+	// Metrics history tools
+	mcpServer.AddTool(tools.GetMetricsHistoryTool(), handlers.GetMetricsHistory(k8sClient))
+
+	// Incident report tools
+	mcpServer.AddTool(tools.GenerateIncidentReportTool(), handlers.GenerateIncidentReport(k8sClient))
 }
 
 func printToolsOverview() {
@@ -206,6 +369,7 @@ func printToolsOverview() {
 	fmt.Println("    • createPod          - Create new pod from manifest")
 	fmt.Println("    • updatePod          - Update pod labels/annotations")
 	fmt.Println("    • deletePod          - Delete specific pod")
+	fmt.Println("    • evictPod           - Evict pod respecting PDBs")
 	fmt.Println("    • restartPod         - Restart pod by deletion")
 	fmt.Println()
 	fmt.Println("  🔍 Monitoring & Debugging:")
@@ -216,7 +380,10 @@ func printToolsOverview() {
 	fmt.Println("    • getPodResourceUsage - Get resource usage details")
 	fmt.Println()
 	fmt.Println("  📈 Health & Status:")
-	fmt.Println("    • getPodsHealthStatus - Health overview for multiple pods")
+	fmt.Println("    • getPodsHealthStatus - Health overview for multiple pods (+ usage hotspots)")
+	fmt.Println("    • waitForPodCondition - Wait for Ready/Scheduled/... condition")
+	fmt.Println("    • getResourceRatios  - Audit request/limit ratios in namespace")
+	fmt.Println("    • getContainerRestarts - Restart reasons and active restart detection")
 	fmt.Println()
 
 	// Namespace Management Section
@@ -229,6 +396,7 @@ func printToolsOverview() {
 	fmt.Println("    • deleteNamespace        - Standard namespace deletion")
 	fmt.Println("    • forceDeleteNamespace   - Force delete stuck namespaces")
 	fmt.Println("    • smartDeleteNamespace   - Auto-choose deletion strategy")
+	fmt.Println("    • previewNamespaceDeletion - Show what a deletion would destroy")
 	fmt.Println()
 	fmt.Println("  🎛️  Resource Management:")
 	fmt.Println("    • getNamespaceResourceQuota  - Get resource quotas")
@@ -236,6 +404,7 @@ func printToolsOverview() {
 	fmt.Println("    • getNamespaceLimitRanges    - Get limit ranges")
 	fmt.Println("    • setNamespaceLimitRange     - Set limit ranges")
 	fmt.Println("    • getNamespaceResourceUsage  - Resource usage summary")
+	fmt.Println("    • cleanupNamespace           - Remove failed pods, old jobs, orphans")
 	fmt.Println()
 	fmt.Println("  🔍 Monitoring & Export:")
 	fmt.Println("    • getNamespaceEvents        - Get namespace events")
@@ -260,12 +429,15 @@ func printToolsOverview() {
 	fmt.Println("    • pauseDeployment     - Pause deployment rollouts")
 	fmt.Println("    • resumeDeployment    - Resume deployment rollouts")
 	fmt.Println("    • restartDeployment   - Restart deployment")
+	fmt.Println("    • triggerReloadByLabel - Restart a labeled group of workloads")
 	fmt.Println("    • waitForDeployment   - Wait for rollout completion")
+	fmt.Println("    • watchRollout        - Stream live rollout progress")
 	fmt.Println()
 	fmt.Println("  🔧 Configuration Management:")
 	fmt.Println("    • setDeploymentImage      - Update container images")
 	fmt.Println("    • setDeploymentEnv        - Update environment variables")
 	fmt.Println("    • setDeploymentResources  - Update resource limits/requests")
+	fmt.Println("    • setRolloutTiming        - Tune progress deadline/minReadySeconds")
 	fmt.Println("    • patchDeployment         - Apply JSON/strategic patches")
 	fmt.Println()
 	fmt.Println("  🔍 Monitoring & Analysis:")
@@ -277,6 +449,7 @@ func printToolsOverview() {
 	fmt.Println("  🌐 Batch Operations:")
 	fmt.Println("    • listAllDeployments     - List across all namespaces")
 	fmt.Println("    • scaleAllDeployments    - Scale all in namespace")
+	fmt.Println("    • reconcileReplicas      - Find deployments stuck below desired replicas")
 	fmt.Println()
 
 	    // Service Management Section
@@ -293,12 +466,60 @@ func printToolsOverview() {
     fmt.Println("    • testServiceConnectivity - Test service connectivity")
     fmt.Println("    • exposeDeployment        - Expose deployment as service")
     fmt.Println("    • createServiceFromPods   - Create service from pod selector")
+    fmt.Println("    • traceServicePath        - Find where service reachability breaks")
+    fmt.Println("    • checkPortConflicts      - Detect NodePort/port/selector conflicts")
     fmt.Println()
 	
+	// Admission Control Section
+	fmt.Println("🟣 ADMISSION CONTROL")
+	fmt.Println("  🛡️  Webhooks:")
+	fmt.Println("    • listWebhooks           - List validating/mutating webhooks")
+	fmt.Println()
+
+	// CronJob Section
+	fmt.Println("⚫ CRONJOB MANAGEMENT")
+	fmt.Println("  ⏰ Batch Operations:")
+	fmt.Println("    • listCronJobs           - List cronjobs and their schedules")
+	fmt.Println("    • triggerCronJob         - Run a cronjob now with optional overrides")
+	fmt.Println()
+
+	// Generic Resources Section
+	fmt.Println("⚪ GENERIC RESOURCES")
+	fmt.Println("  📦 Export & Backup:")
+	fmt.Println("    • exportKind             - Export all resources of a kind as YAML")
+	fmt.Println()
+	fmt.Println("  🔍 Configuration Drift:")
+	fmt.Println("    • driftFromLastApplied   - Diff live state against last kubectl apply")
+	fmt.Println("    • getRecentlyModified    - What changed lately, newest first")
+	fmt.Println()
+	fmt.Println("  ✏️  Editing:")
+	fmt.Println("    • mergeFields            - Change a few fields with a partial object")
+	fmt.Println("    • rebaseManifest         - Rewrite a manifest for another namespace")
+	fmt.Println()
+
+	// RBAC Section
+	fmt.Println("🔐 ACCESS CONTROL")
+	fmt.Println("  👥 RBAC:")
+	fmt.Println("    • getNamespaceAccess     - Who can do what in a namespace")
+	fmt.Println()
+
+	// Metrics History Section
+	fmt.Println("🟤 METRICS HISTORY")
+	fmt.Println("  📈 Trends:")
+	fmt.Println("    • getMetricsHistory      - Recent CPU/memory series for a pod or node")
+	fmt.Println()
+
+	// Incident Report Section
+	fmt.Println("🚨 INCIDENT RESPONSE")
+	fmt.Println("  📝 Reports:")
+	fmt.Println("    • generateIncidentReport - Pods, events, conditions and logs in one report")
+	fmt.Println()
+
 	// Cluster Overview Section
 	fmt.Println("🔴 CLUSTER OVERVIEW")
 	fmt.Println("  🌍 Global Operations:")
 	fmt.Println("    • getClusterOverview     - Cluster-wide resource overview")
+	fmt.Println("    • getControlPlaneHealth  - Per-check /readyz and /livez status")
 	fmt.Println()
 
 	fmt.Println("🔧 ═══════════════════════════════════════════════════════════════")
@@ -308,5 +529,5 @@ func printToolsOverview() {
 }
 
 func getTotalToolCount() int {
-	return 42 // Update this count as you add more tools
+	return 66 // Update this count as you add more tools
 }
@@ -83,6 +83,15 @@ func GetNamespaceAllResourcesTool() mcp.Tool {
 	)
 }
 
+// PreviewNamespaceDeletionTool creates a tool for previewing what a namespace deletion would destroy
+func PreviewNamespaceDeletionTool() mcp.Tool {
+	return mcp.NewTool(
+		"previewNamespaceDeletion",
+		mcp.WithDescription("Preview the impact of deleting a namespace without deleting anything: lists every resource that would be destroyed (custom resources included), flags PVCs and StatefulSets whose data would be lost (checking volume reclaim policies), notes finalizers that may make deletion hang and returns an overall risk level. Run this before deleteNamespace, forceDeleteNamespace or smartDeleteNamespace"),
+		mcp.WithString("namespace", mcp.Required(), mcp.Description("The namespace to assess")),
+	)
+}
+
 // ForceDeleteNamespaceTool creates a tool for force deleting a namespace
 func ForceDeleteNamespaceTool() mcp.Tool {
 	return mcp.NewTool(
@@ -140,6 +149,21 @@ func SmartDeleteNamespaceTool() mcp.Tool {
 	)
 }
 
+// CleanupNamespaceTool creates a tool for removing leftover resources from a namespace
+func CleanupNamespaceTool() mcp.Tool {
+	return mcp.NewTool(
+		"cleanupNamespace",
+		mcp.WithDescription("Housekeeping pass for a namespace: delete failed/evicted pods, completed jobs older than a given age, and optionally configmaps/secrets no workload references. Returns a categorized summary"),
+		mcp.WithString("namespace", mcp.Required(), mcp.Description("The namespace to clean up")),
+		mcp.WithBoolean("failedPods", mcp.Description("Delete pods in the Failed phase, including evicted pods (default: true)")),
+		mcp.WithBoolean("completedJobs", mcp.Description("Delete completed jobs older than jobMinAgeHours (default: true)")),
+		mcp.WithNumber("jobMinAgeHours", mcp.Description("Only delete jobs that completed at least this many hours ago (default: 24)")),
+		mcp.WithBoolean("orphanedConfigMaps", mcp.Description("Delete configmaps not referenced by any pod, workload template or owner (default: false)")),
+		mcp.WithBoolean("orphanedSecrets", mcp.Description("Delete secrets not referenced by any pod, workload template, service account, ingress or owner; TLS and registry secrets are only reported (default: false)")),
+		mcp.WithBoolean("dryRun", mcp.Description("Report what would be deleted without deleting anything (default: false)")),
+	)
+}
+
 // ========== POD TOOLS ==========
 
 // ListPodsTool creates a tool for listing pods in a namespace
@@ -173,6 +197,8 @@ func GetPodLogsTool() mcp.Tool {
 		mcp.WithNumber("tailLines", mcp.Description("Number of lines to tail from the end of logs (default: 100)")),
 		mcp.WithBoolean("follow", mcp.Description("Follow log output (stream logs)")),
 		mcp.WithBoolean("previous", mcp.Description("Get logs from previous container instance")),
+		mcp.WithString("fromTime", mcp.Description("Only return lines logged at or after this RFC3339 time (e.g., '2024-05-01T14:00:00Z')")),
+		mcp.WithString("toTime", mcp.Description("Only return lines logged at or before this RFC3339 time. With fromTime/toTime, tailLines applies only when given explicitly")),
 	)
 }
 
@@ -207,6 +233,18 @@ func DeletePodTool() mcp.Tool {
 	)
 }
 
+// EvictPodTool creates a tool for evicting a pod while respecting PodDisruptionBudgets
+func EvictPodTool() mcp.Tool {
+	return mcp.NewTool(
+		"evictPod",
+		mcp.WithDescription("Evict a pod using the Eviction API, which respects PodDisruptionBudgets unlike deletePod. Reports whether the eviction was allowed or blocked and by which PDB"),
+		mcp.WithString("name", mcp.Required(), mcp.Description("The name of the pod to evict")),
+		mcp.WithString("namespace", mcp.Required(), mcp.Description("The namespace of the pod")),
+		mcp.WithNumber("gracePeriodSeconds", mcp.Description("Grace period for pod termination; 0 terminates immediately (default: the pod's own terminationGracePeriodSeconds)")),
+		mcp.WithBoolean("dryRun", mcp.Description("Check whether the eviction would be allowed without evicting the pod (default: false)")),
+	)
+}
+
 // GetPodEventsTool creates a tool for getting events related to a pod
 func GetPodEventsTool() mcp.Tool {
 	return mcp.NewTool(
@@ -234,6 +272,8 @@ func CreatePodTool() mcp.Tool {
 		mcp.WithDescription("Create a new pod from a JSON manifest"),
 		mcp.WithString("namespace", mcp.Required(), mcp.Description("The namespace where the pod will be created")),
 		mcp.WithString("manifest", mcp.Required(), mcp.Description("The pod manifest in JSON format (e.g., '{\"apiVersion\":\"v1\",\"kind\":\"Pod\",\"metadata\":{\"name\":\"my-pod\"},\"spec\":{\"containers\":[{\"name\":\"nginx\",\"image\":\"nginx:latest\"}]}}')")),
+		mcp.WithBoolean("waitForScheduled", mcp.Description("Wait until the pod is assigned to a node before returning (default: false)")),
+		mcp.WithNumber("scheduleTimeout", mcp.Description("Seconds to wait for scheduling when waitForScheduled is set (default: 30)")),
 	)
 }
 
@@ -249,6 +289,19 @@ func UpdatePodTool() mcp.Tool {
 	)
 }
 
+// WaitForPodConditionTool creates a tool for waiting until a pod condition reaches a status
+func WaitForPodConditionTool() mcp.Tool {
+	return mcp.NewTool(
+		"waitForPodCondition",
+		mcp.WithDescription("Wait until a pod condition (Ready, Initialized, ContainersReady, PodScheduled) reaches the desired status or the timeout expires. Returns the condition's final state and message"),
+		mcp.WithString("name", mcp.Required(), mcp.Description("The name of the pod")),
+		mcp.WithString("namespace", mcp.Description("The namespace of the pod (default: 'default')")),
+		mcp.WithString("condition", mcp.Required(), mcp.Description("The condition type: 'Ready', 'Initialized', 'ContainersReady' or 'PodScheduled'")),
+		mcp.WithString("status", mcp.Description("The desired status: 'True', 'False' or 'Unknown' (default: 'True')")),
+		mcp.WithNumber("timeoutSeconds", mcp.Description("Maximum seconds to wait (default: 60)")),
+	)
+}
+
 // ========== DEPLOYMENT TOOLS ==========
 
 // ListDeploymentsTool creates a tool for listing deployments in a namespace
@@ -403,6 +456,17 @@ func RestartDeploymentTool() mcp.Tool {
 	)
 }
 
+// TriggerReloadByLabelTool creates a tool for restarting a labeled group of workloads together
+func TriggerReloadByLabelTool() mcp.Tool {
+	return mcp.NewTool(
+		"triggerReloadByLabel",
+		mcp.WithDescription("Trigger a coordinated rolling restart of every deployment and statefulset matching a label selector in a namespace (e.g., after a shared config change), using the restartedAt annotation"),
+		mcp.WithString("labelSelector", mcp.Required(), mcp.Description("Label selector for the workloads to restart (e.g., 'group=payments')")),
+		mcp.WithString("namespace", mcp.Description("The namespace of the workloads (default: 'default')")),
+		mcp.WithBoolean("dryRun", mcp.Description("Report which workloads would be restarted without changing them (default: false)")),
+	)
+}
+
 // WaitForDeploymentTool creates a tool for waiting for deployment to reach desired state
 func WaitForDeploymentTool() mcp.Tool {
 	return mcp.NewTool(
@@ -414,6 +478,17 @@ func WaitForDeploymentTool() mcp.Tool {
 	)
 }
 
+// WatchRolloutTool creates a tool for following a deployment rollout live
+func WatchRolloutTool() mcp.Tool {
+	return mcp.NewTool(
+		"watchRollout",
+		mcp.WithDescription("Watch a deployment rollout as it happens (e.g., right after setDeploymentImage or restartDeployment). Streams progress notifications for new pods appearing, becoming ready, old pods terminating and stuck pods, until the rollout completes, fails or times out. Send a progressToken to receive the live events; the full event log is also returned. On failure the blocking reasons are included"),
+		mcp.WithString("name", mcp.Required(), mcp.Description("The name of the deployment")),
+		mcp.WithString("namespace", mcp.Description("The namespace of the deployment (default: 'default')")),
+		mcp.WithNumber("timeout", mcp.Description("Timeout in seconds (default: 300, max: 1800)")),
+	)
+}
+
 // SetDeploymentImageTool creates a tool for updating container images
 func SetDeploymentImageTool() mcp.Tool {
 	return mcp.NewTool(
@@ -473,6 +548,18 @@ func SetDeploymentResourcesTool() mcp.Tool {
 	)
 }
 
+// SetRolloutTimingTool creates a tool for tuning deployment rollout timing
+func SetRolloutTimingTool() mcp.Tool {
+	return mcp.NewTool(
+		"setRolloutTiming",
+		mcp.WithDescription("Update a deployment's progressDeadlineSeconds and/or minReadySeconds via patch. progressDeadlineSeconds must stay greater than minReadySeconds"),
+		mcp.WithString("name", mcp.Required(), mcp.Description("The name of the deployment")),
+		mcp.WithString("namespace", mcp.Description("The namespace of the deployment (default: 'default')")),
+		mcp.WithNumber("progressDeadlineSeconds", mcp.Description("Seconds a rollout may make no progress before it is reported as failed")),
+		mcp.WithNumber("minReadySeconds", mcp.Description("Seconds a new pod must be ready before it counts as available")),
+	)
+}
+
 // GetDeploymentMetricsTool creates a tool for getting deployment metrics
 func GetDeploymentMetricsTool() mcp.Tool {
 	return mcp.NewTool(
@@ -502,6 +589,17 @@ func ScaleAllDeploymentsTool() mcp.Tool {
 		mcp.WithNumber("replicas", mcp.Required(), mcp.Description("The desired number of replicas for all deployments")),
 		mcp.WithString("labelSelector", mcp.Description("Optional label selector to filter which deployments to scale")),
 		mcp.WithBoolean("dryRun", mcp.Description("Perform a dry run without making changes (default: false)")),
+		mcp.WithNumber("concurrency", mcp.Description("How many deployments to update in parallel (default: 5, max: 20)")),
+	)
+}
+
+// ReconcileReplicasTool creates a tool for finding deployments stuck below their desired replicas
+func ReconcileReplicasTool() mcp.Tool {
+	return mcp.NewTool(
+		"reconcileReplicas",
+		mcp.WithDescription("Find deployments whose replicas do not match spec.replicas after their progress deadline (stuck scaling) and report the blocking reason (quota, scheduling, image pull, crash loop) from replica failures and pod statuses"),
+		mcp.WithString("namespace", mcp.Description("The namespace to check (default: all namespaces)")),
+		mcp.WithBoolean("includeProgressing", mcp.Description("Also report mismatched deployments that have not yet exceeded their progress deadline (default: false)")),
 	)
 }
 
@@ -521,8 +619,17 @@ func GetNamespaceResourceUsageTool() mcp.Tool {
 func GetClusterOverviewTool() mcp.Tool {
 	return mcp.NewTool(
 		"getClusterOverview",
-		mcp.WithDescription("Get cluster-wide overview including nodes, namespaces, and resource counts"),
+		mcp.WithDescription("Get cluster-wide overview including nodes, namespaces, and resource counts (results are cached briefly for dashboard polling)"),
 		mcp.WithBoolean("includeMetrics", mcp.Description("Include resource metrics if available (default: false)")),
+		mcp.WithBoolean("refresh", mcp.Description("Bypass the overview cache and fetch fresh data (default: false)")),
+	)
+}
+
+// GetControlPlaneHealthTool creates a tool for checking control-plane health
+func GetControlPlaneHealthTool() mcp.Tool {
+	return mcp.NewTool(
+		"getControlPlaneHealth",
+		mcp.WithDescription("Check control-plane health through the API server's /readyz?verbose and /livez?verbose endpoints and return each individual check (etcd, informers, post-start hooks, ...). Endpoints restricted by managed clusters are reported as 'restricted' instead of failing"),
 	)
 }
 
@@ -542,9 +649,35 @@ func GetPodResourceUsageTool() mcp.Tool {
 func GetPodsHealthStatusTool() mcp.Tool {
 	return mcp.NewTool(
 		"getPodsHealthStatus",
-		mcp.WithDescription("Get health status overview of all pods in a namespace"),
+		mcp.WithDescription("Get health status overview of all pods in a namespace. With includeUsage, current CPU/memory usage from metrics-server is added and pods are sorted by usage (heaviest first)"),
 		mcp.WithString("namespace", mcp.Description("The namespace to check (default: 'default')")),
 		mcp.WithString("labelSelector", mcp.Description("Optional label selector to filter pods")),
+		mcp.WithBoolean("includeUsage", mcp.Description("Add per-pod and per-container CPU/memory usage and sort by it (requires metrics-server, default: false)")),
+		mcp.WithString("sortBy", mcp.Description("Usage to sort by when includeUsage is set: 'cpu' or 'memory' (default: 'cpu')")),
+	)
+}
+
+// GetResourceRatiosTool creates a tool for auditing request/limit ratios in a namespace
+func GetResourceRatiosTool() mcp.Tool {
+	return mcp.NewTool(
+		"getResourceRatios",
+		mcp.WithDescription("Compute CPU and memory limit/request ratios for every container in a namespace and rank the worst offenders: missing requests, missing limits, or ratios above maxRatio (overcommit risk)"),
+		mcp.WithString("namespace", mcp.Description("The namespace to audit (default: 'default')")),
+		mcp.WithString("labelSelector", mcp.Description("Optional label selector to filter pods")),
+		mcp.WithNumber("maxRatio", mcp.Description("Limit/request ratio above which a container is flagged (default: 4)")),
+		mcp.WithNumber("limit", mcp.Description("Maximum number of offenders to return (default: 20, 0 for all)")),
+	)
+}
+
+// GetContainerRestartsTool creates a tool for inspecting container restart history
+func GetContainerRestartsTool() mcp.Tool {
+	return mcp.NewTool(
+		"getContainerRestarts",
+		mcp.WithDescription("Report per-container restart count, last termination reason/exit code and time since last restart for a pod or a whole deployment, sampling briefly to tell containers restarting right now from ones that restarted long ago"),
+		mcp.WithString("name", mcp.Description("The name of the pod (either name or deployment is required)")),
+		mcp.WithString("deployment", mcp.Description("The name of a deployment whose pods should be inspected")),
+		mcp.WithString("namespace", mcp.Description("The namespace (default: 'default')")),
+		mcp.WithNumber("sampleSeconds", mcp.Description("Seconds to watch restart counts for ongoing restarts (default: 10, 0 to skip sampling, max: 120)")),
 	)
 }
 
@@ -703,6 +836,25 @@ func GetServiceTopologyTool() mcp.Tool {
 	)
 }
 
+// TraceServicePathTool creates a tool for diagnosing service reachability end to end
+func TraceServicePathTool() mcp.Tool {
+	return mcp.NewTool(
+		"traceServicePath",
+		mcp.WithDescription("Walk a service's full path (service -> selector -> matching pods -> readiness -> container ports -> endpoints) and report step by step where the chain breaks"),
+		mcp.WithString("name", mcp.Required(), mcp.Description("The name of the service")),
+		mcp.WithString("namespace", mcp.Description("The namespace of the service (default: 'default')")),
+	)
+}
+
+// CheckPortConflictsTool creates a tool for detecting conflicting service ports
+func CheckPortConflictsTool() mcp.Tool {
+	return mcp.NewTool(
+		"checkPortConflicts",
+		mcp.WithDescription("Scan services for NodePort collisions, duplicate port definitions within a service, and multiple services selecting the same pods on overlapping ports. Also reports NodePort range usage"),
+		mcp.WithString("namespace", mcp.Description("The namespace to scan (default: all namespaces; NodePorts are always checked cluster-wide)")),
+	)
+}
+
 // CreateServiceFromPodsTool creates a tool for creating services from pod selectors
 func CreateServiceFromPodsTool() mcp.Tool {
 	return mcp.NewTool(
@@ -716,3 +868,145 @@ func CreateServiceFromPodsTool() mcp.Tool {
 		mcp.WithString("namespace", mcp.Description("The namespace (default: 'default')")),
 	)
 }
+
+// ========== ADMISSION WEBHOOK TOOLS ==========
+
+// ListWebhooksTool creates a tool for listing admission webhooks
+func ListWebhooksTool() mcp.Tool {
+	return mcp.NewTool(
+		"listWebhooks",
+		mcp.WithDescription("List validating and mutating admission webhooks with their rules, failure policy and backing service (useful to explain rejected or mutated requests)"),
+		mcp.WithString("type", mcp.Description("Webhook type to list: 'validating', 'mutating' or 'all' (default: 'all')")),
+		mcp.WithString("resource", mcp.Description("Only show webhooks intercepting this resource (e.g., 'pods', 'deployments', or a subresource such as 'pods/exec')")),
+	)
+}
+
+// ========== CRONJOB TOOLS ==========
+
+// ListCronJobsTool creates a tool for listing cronjobs in a namespace
+func ListCronJobsTool() mcp.Tool {
+	return mcp.NewTool(
+		"listCronJobs",
+		mcp.WithDescription("List cronjobs in a namespace with their schedule, suspension state and last run times"),
+		mcp.WithString("namespace", mcp.Description("The namespace to list cronjobs from (default: 'default')")),
+	)
+}
+
+// TriggerCronJobTool creates a tool for manually running a cronjob with optional overrides
+func TriggerCronJobTool() mcp.Tool {
+	return mcp.NewTool(
+		"triggerCronJob",
+		mcp.WithDescription("Manually run a cronjob by creating a Job from its template, optionally overriding the command, args or env of one container for this run only. Returns the created Job name"),
+		mcp.WithString("name", mcp.Required(), mcp.Description("The name of the cronjob")),
+		mcp.WithString("namespace", mcp.Description("The namespace of the cronjob (default: 'default')")),
+		mcp.WithString("container", mcp.Description("The container to apply overrides to (default: the first container)")),
+		mcp.WithString("command", mcp.Description("Command override as a JSON array (e.g., '[\"/bin/migrate\"]')")),
+		mcp.WithString("args", mcp.Description("Args override as a JSON array (e.g., '[\"--dry-run\",\"--verbose\"]')")),
+		mcp.WithString("env", mcp.Description("Environment variables to set or replace in JSON format (e.g., '{\"LOG_LEVEL\":\"debug\"}')")),
+	)
+}
+
+// ========== GENERIC RESOURCE TOOLS ==========
+
+// ExportKindTool creates a tool for exporting all resources of a kind as a YAML bundle
+func ExportKindTool() mcp.Tool {
+	return mcp.NewTool(
+		"exportKind",
+		mcp.WithDescription("Export every resource of a kind in a namespace (or the cluster for cluster-scoped kinds) as a sanitized multi-document YAML bundle for backup or migration. Objects owned by a controller are skipped since their owner recreates them"),
+		mcp.WithString("kind", mcp.Required(), mcp.Description("The resource kind to export (e.g., 'Deployment', 'configmaps', 'svc')")),
+		mcp.WithString("namespace", mcp.Description("The namespace to export from (default: 'default', ignored for cluster-scoped kinds)")),
+		mcp.WithBoolean("includeSystem", mcp.Description("Include objects managed by Kubernetes itself such as kube-root-ca.crt, default service accounts and token secrets (default: false)")),
+		mcp.WithString("excludeSelector", mcp.Description("Label selector of resources to leave out (e.g., 'backup=skip')")),
+	)
+}
+
+// DriftFromLastAppliedTool creates a tool for detecting drift from the last 'kubectl apply'
+func DriftFromLastAppliedTool() mcp.Tool {
+	return mcp.NewTool(
+		"driftFromLastApplied",
+		mcp.WithDescription("Compare a live resource against its kubectl.kubernetes.io/last-applied-configuration annotation and report fields that drifted from what was last applied (manual edits, controller mutations)"),
+		mcp.WithString("kind", mcp.Required(), mcp.Description("The resource kind (e.g., 'Deployment', 'configmaps', 'svc')")),
+		mcp.WithString("name", mcp.Required(), mcp.Description("The name of the resource")),
+		mcp.WithString("namespace", mcp.Description("The namespace of the resource (default: 'default', ignored for cluster-scoped kinds)")),
+	)
+}
+
+// MergeFieldsTool creates a tool for changing a few fields of any supported resource
+func MergeFieldsTool() mcp.Tool {
+	return mcp.NewTool(
+		"mergeFields",
+		mcp.WithDescription("Change just a few fields of a resource by passing a partial object (e.g., '{\"spec\":{\"replicas\":3}}'), applied as a JSON merge patch with strict schema validation by the API server. Setting a field to null removes it; lists are replaced as a whole"),
+		mcp.WithString("kind", mcp.Required(), mcp.Description("The resource kind (e.g., 'Deployment', 'configmaps', 'svc')")),
+		mcp.WithString("name", mcp.Required(), mcp.Description("The name of the resource")),
+		mcp.WithString("namespace", mcp.Description("The namespace of the resource (default: 'default', ignored for cluster-scoped kinds)")),
+		mcp.WithString("fields", mcp.Required(), mcp.Description("The partial object with only the fields to change, as JSON")),
+		mcp.WithBoolean("dryRun", mcp.Description("Validate and preview the change without persisting it (default: false)")),
+	)
+}
+
+// RebaseManifestTool creates a tool for moving manifests to another namespace
+func RebaseManifestTool() mcp.Tool {
+	return mcp.NewTool(
+		"rebaseManifest",
+		mcp.WithDescription("Rewrite a manifest (or a live resource) for another namespace: sets metadata.namespace, strips cluster-specific fields and owner references, rewrites RoleBinding subjects and '<svc>.<namespace>.svc' DNS names, and lists the configmaps, secrets, claims and service accounts the copy depends on. Returns YAML ready to apply"),
+		mcp.WithString("targetNamespace", mcp.Required(), mcp.Description("The namespace the manifest should be rebased onto")),
+		mcp.WithString("manifest", mcp.Description("The YAML or JSON manifest to rebase (multiple documents separated by '---' are supported)")),
+		mcp.WithString("kind", mcp.Description("The kind of a live resource to rebase when no manifest is given (e.g., 'Deployment', 'cm')")),
+		mcp.WithString("name", mcp.Description("The name of the live resource to rebase when no manifest is given")),
+		mcp.WithString("sourceNamespace", mcp.Description("The namespace the resource comes from (default: the manifest's namespace, or 'default' for live resources)")),
+	)
+}
+
+// GetRecentlyModifiedTool creates a tool for finding what changed lately
+func GetRecentlyModifiedTool() mcp.Tool {
+	return mcp.NewTool(
+		"getRecentlyModified",
+		mcp.WithDescription("List resources of a kind sorted by their most recent change, to answer 'what changed right before things broke'. Kubernetes stores no modification time, so it is approximated from creationTimestamp, managedFields write times and recent events; generation ahead of observedGeneration is flagged as pendingReconcile"),
+		mcp.WithString("kind", mcp.Required(), mcp.Description("The resource kind (e.g., 'Deployment', 'configmaps', 'svc')")),
+		mcp.WithString("namespace", mcp.Description("The namespace to search (default: 'default', ignored for cluster-scoped kinds)")),
+		mcp.WithNumber("sinceMinutes", mcp.Description("Only include resources changed within this many minutes (default: 0, no limit)")),
+		mcp.WithNumber("limit", mcp.Description("Maximum number of resources to return (default: 20)")),
+	)
+}
+
+// ========== RBAC TOOLS ==========
+
+// GetNamespaceAccessTool creates a tool for summarizing who can do what in a namespace
+func GetNamespaceAccessTool() mcp.Tool {
+	return mcp.NewTool(
+		"getNamespaceAccess",
+		mcp.WithDescription("Build an access matrix for a namespace: resolves its RoleBindings and all ClusterRoleBindings and maps each user, group and service account to its effective verbs on key resource types"),
+		mcp.WithString("namespace", mcp.Required(), mcp.Description("The namespace to review")),
+		mcp.WithString("resources", mcp.Description("Comma-separated resource types to include (default: pods,deployments,services,configmaps,secrets,persistentvolumeclaims,jobs,ingresses,roles,rolebindings)")),
+		mcp.WithBoolean("includeSystem", mcp.Description("Include system: subjects and bindings created by Kubernetes; system:authenticated and system:unauthenticated are always shown (default: false)")),
+	)
+}
+
+// ========== METRICS HISTORY TOOLS ==========
+
+// GetMetricsHistoryTool creates a tool for querying sampled usage history of a pod or node
+func GetMetricsHistoryTool() mcp.Tool {
+	return mcp.NewTool(
+		"getMetricsHistory",
+		mcp.WithDescription("Get recent CPU and memory usage series for a pod or node, recorded in memory by the background sampler (requires the server to run with --metrics-history and metrics-server in the cluster)"),
+		mcp.WithString("kind", mcp.Required(), mcp.Description("The resource type: 'pod' or 'node'")),
+		mcp.WithString("name", mcp.Required(), mcp.Description("The name of the pod or node")),
+		mcp.WithString("namespace", mcp.Description("The namespace of the pod (default: 'default', ignored for nodes)")),
+		mcp.WithNumber("minutes", mcp.Description("Only return samples from the last N minutes (default: 30, 0 returns everything retained)")),
+	)
+}
+
+// ========== INCIDENT REPORT TOOLS ==========
+
+// GenerateIncidentReportTool creates a tool for bundling incident context into one report
+func GenerateIncidentReportTool() mcp.Tool {
+	return mcp.NewTool(
+		"generateIncidentReport",
+		mcp.WithDescription("Generate a structured incident report for a namespace or a single deployment in one call: pod health and usage, unhealthy deployments with conditions and blockers, recent warning events with top reasons, and recent logs from failing pods, plus a short list of findings. Suitable for sharing or for root-cause analysis"),
+		mcp.WithString("namespace", mcp.Description("The namespace to report on (default: 'default')")),
+		mcp.WithString("deployment", mcp.Description("Limit the report to this deployment and its pods (default: whole namespace)")),
+		mcp.WithNumber("sinceMinutes", mcp.Description("How far back to include warning events (default: 60)")),
+		mcp.WithNumber("logTailLines", mcp.Description("Log lines to include per failing container (default: 50)")),
+		mcp.WithNumber("maxLogPods", mcp.Description("Maximum number of failing pods to fetch logs for (default: 5)")),
+	)
+}